@@ -0,0 +1,69 @@
+// Command tinymon-sweep deletes test hosts and checks whose address or name
+// starts with tf-acc-. It reads the server and credentials from the same environment
+// variables as the provider:
+//
+//	TINYMON_URL=https://mon.example.com TINYMON_API_KEY=... go run ./cmd/tinymon-sweep
+//
+// Run it after testing against a shared instance, also when a run failed.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/internal/sweep"
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+func main() {
+	var (
+		prefix string
+		dryRun bool
+	)
+	flag.StringVar(&prefix, "prefix", sweep.Prefix, "delete hosts and checks whose address or name starts with this prefix")
+	flag.BoolVar(&dryRun, "dry-run", false, "only print what would be deleted")
+	flag.Parse()
+
+	url := os.Getenv("TINYMON_URL")
+	if url == "" {
+		log.Fatal("TINYMON_URL must be set")
+	}
+
+	apiKey := os.Getenv("TINYMON_API_KEY")
+	if file := os.Getenv("TINYMON_API_KEY_FILE"); apiKey == "" && file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			log.Fatalf("reading TINYMON_API_KEY_FILE: %s", err)
+		}
+		apiKey = strings.TrimSpace(string(data))
+	}
+	username := os.Getenv("TINYMON_USERNAME")
+	if apiKey == "" && username == "" {
+		log.Fatal("TINYMON_API_KEY, TINYMON_API_KEY_FILE or TINYMON_USERNAME must be set")
+	}
+
+	client := tinymon.New(tinymon.Config{
+		URL:          url,
+		APIKey:       apiKey,
+		Username:     username,
+		Password:     os.Getenv("TINYMON_PASSWORD"),
+		Organization: os.Getenv("TINYMON_ORGANIZATION"),
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	sweeper := &sweep.Sweeper{
+		Client: client,
+		Prefix: prefix,
+		DryRun: dryRun,
+		Logf:   log.Printf,
+	}
+	if err := sweeper.Sweep(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                   = &acknowledgeAlertsAction{}
+	_ action.ActionWithConfigure      = &acknowledgeAlertsAction{}
+	_ action.ActionWithValidateConfig = &acknowledgeAlertsAction{}
+)
+
+func NewAcknowledgeAlertsAction() action.Action {
+	return &acknowledgeAlertsAction{}
+}
+
+type acknowledgeAlertsAction struct {
+	client *TinyMonClient
+}
+
+type acknowledgeAlertsActionModel struct {
+	HostAddress types.String `tfsdk:"host_address"`
+	CheckID     types.Int64  `tfsdk:"check_id"`
+	Comment     types.String `tfsdk:"comment"`
+}
+
+type acknowledgeAlertsAPIRequest struct {
+	HostAddress string `json:"host_address,omitempty"`
+	CheckID     int64  `json:"check_id,omitempty"`
+	Comment     string `json:"comment"`
+}
+
+type acknowledgeAlertsAPIResponse struct {
+	Acknowledged int64 `json:"acknowledged"`
+}
+
+func (a *acknowledgeAlertsAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acknowledge_alerts"
+}
+
+func (a *acknowledgeAlertsAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Acknowledges the active alerts of a host or a single check, e.g. for an expected alert during a rollout.",
+		Attributes: map[string]schema.Attribute{
+			"host_address": schema.StringAttribute{
+				Description: "Acknowledge the alerts of all checks of this host. Conflicts with check_id.",
+				Optional:    true,
+			},
+			"check_id": schema.Int64Attribute{
+				Description: "Acknowledge the alert of this check. Conflicts with host_address.",
+				Optional:    true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment shown with the acknowledgement.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *acknowledgeAlertsAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	a.client = client
+}
+
+func (a *acknowledgeAlertsAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config acknowledgeAlertsActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.HostAddress.IsNull() == config.CheckID.IsNull() {
+		resp.Diagnostics.AddError("Invalid target",
+			"Exactly one of host_address or check_id must be set.")
+	}
+}
+
+func (a *acknowledgeAlertsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config acknowledgeAlertsActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := acknowledgeAlertsAPIRequest{
+		HostAddress: config.HostAddress.ValueString(),
+		CheckID:     config.CheckID.ValueInt64(),
+		Comment:     config.Comment.ValueString(),
+	}
+
+	var result acknowledgeAlertsAPIResponse
+	if err := a.client.DoJSON(ctx, "POST", "/api/push/alerts/acknowledge", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error acknowledging alerts", err)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Acknowledged %d alert(s)", result.Acknowledged)})
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &activeAlertsDataSource{}
+
+func NewActiveAlertsDataSource() datasource.DataSource {
+	return &activeAlertsDataSource{}
+}
+
+type activeAlertsDataSource struct {
+	client *TinyMonClient
+}
+
+type activeAlertsDataSourceModel struct {
+	HostAddress types.String                       `tfsdk:"host_address"`
+	Topic       types.String                       `tfsdk:"topic"`
+	Severity    types.String                       `tfsdk:"severity"`
+	AlertCount  types.Int64                        `tfsdk:"alert_count"`
+	Alerts      []activeAlertsDataSourceAlertModel `tfsdk:"alerts"`
+}
+
+type activeAlertsDataSourceAlertModel struct {
+	CheckID      types.Int64  `tfsdk:"check_id"`
+	HostAddress  types.String `tfsdk:"host_address"`
+	CheckType    types.String `tfsdk:"check_type"`
+	CheckName    types.String `tfsdk:"check_name"`
+	Severity     types.String `tfsdk:"severity"`
+	Message      types.String `tfsdk:"message"`
+	Since        types.String `tfsdk:"since"`
+	Acknowledged types.Bool   `tfsdk:"acknowledged"`
+}
+
+type activeAlertAPIResponse struct {
+	CheckID      int64  `json:"check_id"`
+	HostAddress  string `json:"host_address"`
+	CheckType    string `json:"check_type"`
+	CheckName    string `json:"check_name"`
+	Severity     string `json:"severity"`
+	Message      string `json:"message"`
+	Since        string `json:"since"`
+	Acknowledged bool   `json:"acknowledged"`
+}
+
+func (d *activeAlertsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_active_alerts"
+}
+
+func (d *activeAlertsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists currently firing alerts, e.g. to stop a pipeline while monitoring shows active problems.",
+		Attributes: map[string]schema.Attribute{
+			"host_address": schema.StringAttribute{
+				Description: "Only return alerts of the host with this address.",
+				Optional:    true,
+			},
+			"topic": schema.StringAttribute{
+				Description: "Only return alerts of hosts in this topic or below it.",
+				Optional:    true,
+			},
+			"severity": schema.StringAttribute{
+				Description: "Only return alerts of this severity: warning or critical.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("warning", "critical"),
+				},
+			},
+			"alert_count": schema.Int64Attribute{
+				Description: "Number of matching alerts.",
+				Computed:    true,
+			},
+			"alerts": schema.ListNestedAttribute{
+				Description: "Matching alerts, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"check_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"host_address": schema.StringAttribute{
+							Computed: true,
+						},
+						"check_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"check_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"severity": schema.StringAttribute{
+							Computed: true,
+						},
+						"message": schema.StringAttribute{
+							Computed: true,
+						},
+						"since": schema.StringAttribute{
+							Description: "When the check entered the alerting state, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"acknowledged": schema.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *activeAlertsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *activeAlertsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state activeAlertsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if !state.HostAddress.IsNull() {
+		query.Set("host_address", state.HostAddress.ValueString())
+	}
+	if !state.Topic.IsNull() {
+		query.Set("topic", state.Topic.ValueString())
+	}
+	if !state.Severity.IsNull() {
+		query.Set("severity", state.Severity.ValueString())
+	}
+
+	var result []activeAlertAPIResponse
+	if err := d.client.DoJSON(ctx, "GET", "/api/push/alerts?"+query.Encode(), nil, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error listing active alerts", err)
+		return
+	}
+
+	state.AlertCount = types.Int64Value(int64(len(result)))
+	state.Alerts = make([]activeAlertsDataSourceAlertModel, 0, len(result))
+	for _, alert := range result {
+		state.Alerts = append(state.Alerts, activeAlertsDataSourceAlertModel{
+			CheckID:      types.Int64Value(alert.CheckID),
+			HostAddress:  types.StringValue(alert.HostAddress),
+			CheckType:    types.StringValue(alert.CheckType),
+			CheckName:    types.StringValue(alert.CheckName),
+			Severity:     types.StringValue(alert.Severity),
+			Message:      types.StringValue(alert.Message),
+			Since:        types.StringValue(alert.Since),
+			Acknowledged: types.BoolValue(alert.Acknowledged),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
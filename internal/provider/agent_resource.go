@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &agentResource{}
+	_ resource.ResourceWithImportState = &agentResource{}
+)
+
+func NewAgentResource() resource.Resource {
+	return &agentResource{}
+}
+
+type agentResource struct {
+	client *TinyMonClient
+}
+
+type agentResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	HostAddress     types.String `tfsdk:"host_address"`
+	CheckTypes      types.Set    `tfsdk:"check_types"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	EnrollmentToken types.String `tfsdk:"enrollment_token"`
+}
+
+type agentAPIRequest struct {
+	Name        string   `json:"name"`
+	HostAddress string   `json:"host_address"`
+	CheckTypes  []string `json:"check_types"`
+	Enabled     bool     `json:"enabled"`
+}
+
+// agentAPIResponse only carries the enrollment token in the response to the
+// POST that registers the agent.
+type agentAPIResponse struct {
+	ID              int64    `json:"id"`
+	Name            string   `json:"name"`
+	HostAddress     string   `json:"host_address"`
+	CheckTypes      []string `json:"check_types"`
+	Enabled         bool     `json:"enabled"`
+	EnrollmentToken string   `json:"enrollment_token"`
+}
+
+func (r *agentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent"
+}
+
+func (r *agentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Registers a monitoring agent for a host. The agent enrolls with the enrollment token and then runs the host's agent-based checks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the agent shown in the UI.",
+				Required:    true,
+			},
+			"host_address": schema.StringAttribute{
+				Description: "Address of the host the agent runs on. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"check_types": schema.SetAttribute{
+				Description: "Check types the agent may run. The agent may run all types when unset.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setValuesOneOf(knownCheckTypes...),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Disabled agents stay registered but get no checks assigned.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"enrollment_token": schema.StringAttribute{
+				Description: "Token the agent enrolls with. Only returned when the agent is registered; use the tinymon_agent_token ephemeral resource to keep enrollment tokens out of state.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *agentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *agentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan agentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := agentToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result agentAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/agents", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating agent", err)
+		return
+	}
+
+	mapAgentResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *agentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state agentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/agents/%d", state.ID.ValueInt64())
+
+	var result agentAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading agent", err)
+		return
+	}
+
+	mapAgentResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *agentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan agentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := agentToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/agents/%d", plan.ID.ValueInt64())
+
+	var result agentAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating agent", err)
+		return
+	}
+
+	mapAgentResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *agentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state agentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting the agent also revokes its enrollment.
+	apiPath := fmt.Sprintf("/api/push/agents/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting agent", err)
+		return
+	}
+}
+
+func (r *agentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric agent ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// agentToAPI sends an empty check_types list when unset, which permits all
+// check types.
+func agentToAPI(ctx context.Context, plan *agentResourceModel) (agentAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	checkTypes := []string{}
+	if !plan.CheckTypes.IsNull() {
+		diags.Append(plan.CheckTypes.ElementsAs(ctx, &checkTypes, false)...)
+	}
+
+	body := agentAPIRequest{
+		Name:        plan.Name.ValueString(),
+		HostAddress: plan.HostAddress.ValueString(),
+		CheckTypes:  checkTypes,
+		Enabled:     plan.Enabled.ValueBool(),
+	}
+	return body, diags
+}
+
+func mapAgentResponseToState(apiResp *agentAPIResponse, state *agentResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.HostAddress = types.StringValue(apiResp.HostAddress)
+	state.CheckTypes = stringSetToState(apiResp.CheckTypes, state.CheckTypes)
+	state.Enabled = types.BoolValue(apiResp.Enabled)
+
+	// The token is only sent once; keep it from state afterwards. Imported
+	// agents have no token in state.
+	if apiResp.EnrollmentToken != "" {
+		state.EnrollmentToken = types.StringValue(apiResp.EnrollmentToken)
+	} else if state.EnrollmentToken.IsNull() || state.EnrollmentToken.IsUnknown() {
+		state.EnrollmentToken = types.StringNull()
+	}
+}
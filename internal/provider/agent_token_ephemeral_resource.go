@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &agentTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &agentTokenEphemeralResource{}
+)
+
+func NewAgentTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &agentTokenEphemeralResource{}
+}
+
+type agentTokenEphemeralResource struct {
+	client *TinyMonClient
+}
+
+type agentTokenEphemeralResourceModel struct {
+	AgentID    types.Int64  `tfsdk:"agent_id"`
+	TTLSeconds types.Int64  `tfsdk:"ttl_seconds"`
+	Token      types.String `tfsdk:"token"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+type agentTokenAPIRequest struct {
+	TTLSeconds int64 `json:"ttl_seconds"`
+}
+
+type agentTokenAPIResponse struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+func (r *agentTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_token"
+}
+
+func (r *agentTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a single-use enrollment token for a tinymon_agent. The token is never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"agent_id": schema.Int64Attribute{
+				Description: "ID of the agent (tinymon_agent) the token enrolls.",
+				Required:    true,
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				Description: "Time the token stays valid for enrollment, in seconds. Defaults to 3600.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64Between(60, 604800),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "The generated enrollment token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiry time in RFC 3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *agentTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *agentTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data agentTokenEphemeralResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ttl := int64(3600)
+	if !data.TTLSeconds.IsNull() {
+		ttl = data.TTLSeconds.ValueInt64()
+	}
+
+	apiPath := fmt.Sprintf("/api/push/agents/%d/tokens", data.AgentID.ValueInt64())
+
+	var result agentTokenAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", apiPath, agentTokenAPIRequest{TTLSeconds: ttl}, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating agent enrollment token", err)
+		return
+	}
+
+	data.TTLSeconds = types.Int64Value(ttl)
+	data.Token = types.StringValue(result.Token)
+	data.ExpiresAt = types.StringValue(result.ExpiresAt)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,409 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ resource.Resource                   = &alertRoutingRuleResource{}
+	_ resource.ResourceWithImportState    = &alertRoutingRuleResource{}
+	_ resource.ResourceWithValidateConfig = &alertRoutingRuleResource{}
+)
+
+var weekdays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+var alertRoutingTimeOfDayAttrTypes = map[string]attr.Type{
+	"start":    types.StringType,
+	"end":      types.StringType,
+	"weekdays": types.SetType{ElemType: types.StringType},
+	"timezone": types.StringType,
+}
+
+func NewAlertRoutingRuleResource() resource.Resource {
+	return &alertRoutingRuleResource{}
+}
+
+type alertRoutingRuleResource struct {
+	client *TinyMonClient
+}
+
+type alertRoutingRuleResourceModel struct {
+	ID                     types.Int64  `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	ParentID               types.Int64  `tfsdk:"parent_id"`
+	Position               types.Int64  `tfsdk:"position"`
+	Topics                 types.Set    `tfsdk:"topics"`
+	Severities             types.Set    `tfsdk:"severities"`
+	TagIDs                 types.Set    `tfsdk:"tag_ids"`
+	TimeOfDay              types.Object `tfsdk:"time_of_day"`
+	NotificationChannelIDs types.Set    `tfsdk:"notification_channel_ids"`
+	ContactIDs             types.Set    `tfsdk:"contact_ids"`
+	ContinueMatching       types.Bool   `tfsdk:"continue_matching"`
+}
+
+type alertRoutingTimeOfDayModel struct {
+	Start    types.String `tfsdk:"start"`
+	End      types.String `tfsdk:"end"`
+	Weekdays types.Set    `tfsdk:"weekdays"`
+	Timezone types.String `tfsdk:"timezone"`
+}
+
+type alertRoutingRuleAPIRequest struct {
+	Name                   string                     `json:"name"`
+	ParentID               int64                      `json:"parent_id,omitempty"`
+	Position               int64                      `json:"position"`
+	Topics                 []string                   `json:"topics"`
+	Severities             []string                   `json:"severities"`
+	TagIDs                 []int64                    `json:"tag_ids"`
+	TimeOfDay              *alertRoutingTimeOfDayJSON `json:"time_of_day"`
+	NotificationChannelIDs []int64                    `json:"notification_channel_ids"`
+	ContactIDs             []int64                    `json:"contact_ids"`
+	ContinueMatching       bool                       `json:"continue_matching"`
+}
+
+type alertRoutingRuleAPIResponse struct {
+	ID                     int64                      `json:"id"`
+	Name                   string                     `json:"name"`
+	ParentID               int64                      `json:"parent_id"`
+	Position               int64                      `json:"position"`
+	Topics                 []string                   `json:"topics"`
+	Severities             []string                   `json:"severities"`
+	TagIDs                 []int64                    `json:"tag_ids"`
+	TimeOfDay              *alertRoutingTimeOfDayJSON `json:"time_of_day"`
+	NotificationChannelIDs []int64                    `json:"notification_channel_ids"`
+	ContactIDs             []int64                    `json:"contact_ids"`
+	ContinueMatching       bool                       `json:"continue_matching"`
+}
+
+type alertRoutingTimeOfDayJSON struct {
+	Start    string   `json:"start"`
+	End      string   `json:"end"`
+	Weekdays []string `json:"weekdays"`
+	Timezone string   `json:"timezone,omitempty"`
+}
+
+func (r *alertRoutingRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_routing_rule"
+}
+
+func (r *alertRoutingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a node of the alert routing tree. Alerts are matched against top-level rules in position order, then against the children of the matching rule; the deepest matching rule's channels are notified.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the rule.",
+				Required:    true,
+			},
+			"parent_id": schema.Int64Attribute{
+				Description: "Rule this rule is nested under. Only alerts matched by the parent are considered. Top-level rule if unset.",
+				Optional:    true,
+			},
+			"position": schema.Int64Attribute{
+				Description: "Order among rules with the same parent; lower numbers are evaluated first.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+			},
+			"topics": schema.SetAttribute{
+				Description: "Match alerts of hosts in one of these topics. Any topic if unset.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"severities": schema.SetAttribute{
+				Description: "Match alerts of these severities (warning, critical). Any severity if unset.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setValuesOneOf("warning", "critical"),
+				},
+			},
+			"tag_ids": schema.SetAttribute{
+				Description: "Match alerts of checks carrying one of these tags (tinymon_tag). Any tags if unset.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"notification_channel_ids": schema.SetAttribute{
+				Description: "Notification channels alerts are sent to when no child rule matches.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"contact_ids": schema.SetAttribute{
+				Description: "Contacts (tinymon_contact) notified directly when no child rule matches, outside their quiet hours.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"continue_matching": schema.BoolAttribute{
+				Description: "Keep evaluating the following sibling rules after this rule matched, so an alert can be routed to several branches.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			// Attributes are Optional because the framework enforces Required
+			// attributes of a single nested block even when it is absent.
+			"time_of_day": schema.SingleNestedBlock{
+				Description: "Only match alerts raised within this daily period, e.g. business hours.",
+				Attributes: map[string]schema.Attribute{
+					"start": schema.StringAttribute{
+						Description: "Start of the period as HH:MM. Required.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringMatches(clockTime, "a time of day like 08:00"),
+						},
+					},
+					"end": schema.StringAttribute{
+						Description: "End of the period as HH:MM. May be earlier than start to span midnight. Required.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringMatches(clockTime, "a time of day like 18:00"),
+						},
+					},
+					"weekdays": schema.SetAttribute{
+						Description: "Days the period applies on (mon, tue, wed, thu, fri, sat, sun). Every day if unset.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setValuesOneOf(weekdays...),
+						},
+					},
+					"timezone": schema.StringAttribute{
+						Description: "IANA time zone of start and end. Defaults to the server's time zone.",
+						Optional:    true,
+						Validators: []validator.String{
+							timezone(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *alertRoutingRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *alertRoutingRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config alertRoutingRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.TimeOfDay.IsNull() && !config.TimeOfDay.IsUnknown() {
+		attrs := config.TimeOfDay.Attributes()
+		for _, name := range []string{"start", "end"} {
+			if value, ok := attrs[name]; ok && value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("time_of_day").AtName(name), "Missing required argument",
+					fmt.Sprintf("The argument %q is required in time_of_day.", name))
+			}
+		}
+	}
+}
+
+func (r *alertRoutingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan alertRoutingRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := alertRoutingRuleToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result alertRoutingRuleAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/alert_routes", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating alert routing rule", err)
+		return
+	}
+
+	mapAlertRoutingRuleResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *alertRoutingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state alertRoutingRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/alert_routes/%d", state.ID.ValueInt64())
+
+	var result alertRoutingRuleAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading alert routing rule", err)
+		return
+	}
+
+	mapAlertRoutingRuleResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *alertRoutingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan alertRoutingRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := alertRoutingRuleToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/alert_routes/%d", plan.ID.ValueInt64())
+
+	var result alertRoutingRuleAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating alert routing rule", err)
+		return
+	}
+
+	mapAlertRoutingRuleResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *alertRoutingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertRoutingRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/alert_routes/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting alert routing rule", err)
+		return
+	}
+}
+
+func (r *alertRoutingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric routing rule ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// alertRoutingRuleToAPI always sends the matchers and sends time_of_day as
+// null when the block is absent, so matchers removed from the configuration
+// are cleared on the server.
+func alertRoutingRuleToAPI(ctx context.Context, plan *alertRoutingRuleResourceModel) (alertRoutingRuleAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	topics := []string{}
+	if !plan.Topics.IsNull() {
+		diags.Append(plan.Topics.ElementsAs(ctx, &topics, false)...)
+	}
+	severities := []string{}
+	if !plan.Severities.IsNull() {
+		diags.Append(plan.Severities.ElementsAs(ctx, &severities, false)...)
+	}
+
+	body := alertRoutingRuleAPIRequest{
+		Name:                   plan.Name.ValueString(),
+		ParentID:               plan.ParentID.ValueInt64(),
+		Position:               plan.Position.ValueInt64(),
+		Topics:                 topics,
+		Severities:             severities,
+		TagIDs:                 idSetFromPlan(ctx, plan.TagIDs, &diags),
+		NotificationChannelIDs: idSetFromPlan(ctx, plan.NotificationChannelIDs, &diags),
+		ContactIDs:             idSetFromPlan(ctx, plan.ContactIDs, &diags),
+		ContinueMatching:       plan.ContinueMatching.ValueBool(),
+	}
+
+	if !plan.TimeOfDay.IsNull() {
+		var timeOfDay alertRoutingTimeOfDayModel
+		diags.Append(plan.TimeOfDay.As(ctx, &timeOfDay, basetypes.ObjectAsOptions{})...)
+		days := []string{}
+		if !timeOfDay.Weekdays.IsNull() {
+			diags.Append(timeOfDay.Weekdays.ElementsAs(ctx, &days, false)...)
+		}
+		body.TimeOfDay = &alertRoutingTimeOfDayJSON{
+			Start:    timeOfDay.Start.ValueString(),
+			End:      timeOfDay.End.ValueString(),
+			Weekdays: days,
+			Timezone: timeOfDay.Timezone.ValueString(),
+		}
+	}
+
+	return body, diags
+}
+
+func mapAlertRoutingRuleResponseToState(apiResp *alertRoutingRuleAPIResponse, state *alertRoutingRuleResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.ParentID = int64OrNull(apiResp.ParentID)
+	state.Position = types.Int64Value(apiResp.Position)
+	state.Topics = stringSetToState(apiResp.Topics, state.Topics)
+	state.Severities = stringSetToState(apiResp.Severities, state.Severities)
+	state.TagIDs = idSetToState(apiResp.TagIDs, state.TagIDs)
+	state.NotificationChannelIDs = idSetToState(apiResp.NotificationChannelIDs, state.NotificationChannelIDs)
+	state.ContactIDs = idSetToState(apiResp.ContactIDs, state.ContactIDs)
+	state.ContinueMatching = types.BoolValue(apiResp.ContinueMatching)
+
+	if apiResp.TimeOfDay == nil {
+		state.TimeOfDay = types.ObjectNull(alertRoutingTimeOfDayAttrTypes)
+		return
+	}
+
+	currentWeekdays := types.SetNull(types.StringType)
+	if !state.TimeOfDay.IsNull() && !state.TimeOfDay.IsUnknown() {
+		if value, ok := state.TimeOfDay.Attributes()["weekdays"].(types.Set); ok {
+			currentWeekdays = value
+		}
+	}
+
+	state.TimeOfDay = types.ObjectValueMust(alertRoutingTimeOfDayAttrTypes, map[string]attr.Value{
+		"start":    types.StringValue(apiResp.TimeOfDay.Start),
+		"end":      types.StringValue(apiResp.TimeOfDay.End),
+		"weekdays": stringSetToState(apiResp.TimeOfDay.Weekdays, currentWeekdays),
+		"timezone": stringOrNull(apiResp.TimeOfDay.Timezone),
+	})
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &apiTokenEphemeralResource{}
+)
+
+func NewAPITokenEphemeralResource() ephemeral.EphemeralResource {
+	return &apiTokenEphemeralResource{}
+}
+
+type apiTokenEphemeralResource struct {
+	client *TinyMonClient
+}
+
+type apiTokenEphemeralResourceModel struct {
+	Scopes      types.List   `tfsdk:"scopes"`
+	TTLSeconds  types.Int64  `tfsdk:"ttl_seconds"`
+	Description types.String `tfsdk:"description"`
+	Token       types.String `tfsdk:"token"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+type apiTokenAPIRequest struct {
+	Scopes      []string `json:"scopes"`
+	TTLSeconds  int64    `json:"ttl_seconds"`
+	Description string   `json:"description"`
+}
+
+type apiTokenAPIResponse struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+func (r *apiTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *apiTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Mints a scoped, short-lived Push API token. The token is never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"scopes": schema.ListAttribute{
+				Description: "Scopes granted to the token, e.g. [\"hosts:read\", \"checks:write\"].",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				Description: "Token lifetime in seconds (60-604800). Defaults to 3600.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64Between(60, 604800),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Free-form note shown in the TinyMon token list.",
+				Optional:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The generated token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiry time in RFC 3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *apiTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *apiTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiTokenEphemeralResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scopes []string
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ttl := int64(3600)
+	if !data.TTLSeconds.IsNull() {
+		ttl = data.TTLSeconds.ValueInt64()
+	}
+
+	body := apiTokenAPIRequest{
+		Scopes:      scopes,
+		TTLSeconds:  ttl,
+		Description: data.Description.ValueString(),
+	}
+
+	var result apiTokenAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/tokens", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating API token", err)
+		return
+	}
+
+	data.TTLSeconds = types.Int64Value(ttl)
+	data.Token = types.StringValue(result.Token)
+	data.ExpiresAt = types.StringValue(result.ExpiresAt)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+// featureCheckBatch covers POST /api/push/checks/batch.
+var featureCheckBatch = serverFeature{name: "check batch endpoint", since: serverVersion{1, 8, 0}}
+
+const (
+	// checkBatchWindow is how long the first queued check waits for others
+	// before the batch is sent.
+	checkBatchWindow = 100 * time.Millisecond
+	// checkBatchMaxSize sends a batch early once it holds this many checks.
+	checkBatchMaxSize = 100
+)
+
+// checkBatcher coalesces concurrent check upserts into calls to the batch
+// endpoint. Terraform creates resources in parallel, so checks created in the
+// same apply usually arrive within one window.
+type checkBatcher struct {
+	client *TinyMonClient
+
+	mu       sync.Mutex
+	pending  []*checkBatchItem
+	timer    *time.Timer
+	disabled bool
+}
+
+type checkBatchItem struct {
+	ctx  context.Context
+	body tinymon.CheckRequest
+	done chan tinymon.CheckBatchResult
+}
+
+// UpsertCheck creates or updates a check. When the server has the batch
+// endpoint the request is queued and sent together with concurrent upserts;
+// otherwise it is posted on its own. Checks with their own organization are
+// never batched, since a batch is sent for a single organization.
+func (c *TinyMonClient) UpsertCheck(ctx context.Context, body tinymon.CheckRequest) (*tinymon.Check, error) {
+	c.batcherOnce.Do(func() {
+		c.batcher = &checkBatcher{client: c}
+	})
+	if !c.Supports(featureCheckBatch) || c.batcher.isDisabled() || tinymon.OrganizationOverridden(ctx) {
+		return c.Client.UpsertCheck(ctx, body)
+	}
+
+	item := &checkBatchItem{ctx: ctx, body: body, done: make(chan tinymon.CheckBatchResult, 1)}
+	c.batcher.enqueue(item)
+
+	select {
+	case result := <-item.done:
+		return result.Check, result.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (b *checkBatcher) isDisabled() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.disabled
+}
+
+func (b *checkBatcher) enqueue(item *checkBatchItem) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending = append(b.pending, item)
+	switch {
+	case len(b.pending) >= checkBatchMaxSize:
+		if b.timer != nil {
+			b.timer.Stop()
+			b.timer = nil
+		}
+		go b.send(b.take())
+	case b.timer == nil:
+		b.timer = time.AfterFunc(checkBatchWindow, func() {
+			b.mu.Lock()
+			b.timer = nil
+			items := b.take()
+			b.mu.Unlock()
+			b.send(items)
+		})
+	}
+}
+
+// take empties the queue. The caller must hold b.mu.
+func (b *checkBatcher) take() []*checkBatchItem {
+	items := b.pending
+	b.pending = nil
+	return items
+}
+
+func (b *checkBatcher) send(items []*checkBatchItem) {
+	if len(items) == 0 {
+		return
+	}
+
+	// A single check gains nothing from the batch endpoint.
+	if len(items) == 1 {
+		check, err := b.client.Client.UpsertCheck(items[0].ctx, items[0].body)
+		items[0].done <- tinymon.CheckBatchResult{Check: check, Err: err}
+		return
+	}
+
+	checks := make([]tinymon.CheckRequest, len(items))
+	for i, item := range items {
+		checks[i] = item.body
+	}
+
+	// The batch outlives any single caller, so it must not be cancelled with
+	// the first item's context. Values such as the logger are kept.
+	ctx := context.WithoutCancel(items[0].ctx)
+
+	results, err := b.client.UpsertChecks(ctx, checks)
+	if isNotFound(err) {
+		// The server does not have the endpoint after all; stop batching.
+		b.mu.Lock()
+		b.disabled = true
+		b.mu.Unlock()
+		for _, item := range items {
+			check, err := b.client.Client.UpsertCheck(item.ctx, item.body)
+			item.done <- tinymon.CheckBatchResult{Check: check, Err: err}
+		}
+		return
+	}
+	if err != nil {
+		for _, item := range items {
+			item.done <- tinymon.CheckBatchResult{Err: err}
+		}
+		return
+	}
+
+	for i, item := range items {
+		item.done <- results[i]
+	}
+}
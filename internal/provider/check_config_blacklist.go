@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var blacklistCheckConfig = typedCheckConfig{
+	name:      "blacklist_config",
+	checkType: "blacklist",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for blacklist checks. Replaces config. The host's IPv4 addresses are looked up in each DNS blocklist zone.",
+		Attributes: map[string]schema.Attribute{
+			"zones": schema.ListAttribute{
+				Description: "DNSBL zones to query, e.g. zen.spamhaus.org. Required.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"alert_threshold": schema.Int64Attribute{
+				Description: "Number of zones that must list the host for the check to fail. Defaults to 1 on the server, i.e. any listing.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 100),
+				},
+			},
+		},
+	},
+	required: []string{"zones"},
+	validate: validateBlacklistCheckConfig,
+	render:   renderBlacklistCheckConfig,
+}
+
+type blacklistCheckConfigModel struct {
+	Zones          types.List  `tfsdk:"zones"`
+	AlertThreshold types.Int64 `tfsdk:"alert_threshold"`
+}
+
+func validateBlacklistCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model blacklistCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	block := path.Root("blacklist_config")
+
+	if model.Zones.IsNull() || model.Zones.IsUnknown() {
+		return diags
+	}
+	if len(model.Zones.Elements()) == 0 {
+		diags.AddAttributeError(block.AtName("zones"), "Missing zones",
+			"At least one zone is required.")
+		return diags
+	}
+	var zones []types.String
+	if d := model.Zones.ElementsAs(ctx, &zones, false); d.HasError() {
+		diags.Append(d...)
+		return diags
+	}
+	seen := map[string]bool{}
+	for i, zone := range zones {
+		if zone.IsNull() || zone.IsUnknown() {
+			continue
+		}
+		if !domainName.MatchString(zone.ValueString()) {
+			diags.AddAttributeError(block.AtName("zones").AtListIndex(i), "Invalid zone",
+				fmt.Sprintf("zones must contain DNS zone names such as zen.spamhaus.org, got %q.", zone.ValueString()))
+		}
+		if seen[zone.ValueString()] {
+			diags.AddAttributeError(block.AtName("zones").AtListIndex(i), "Duplicate zone",
+				fmt.Sprintf("%q is listed more than once.", zone.ValueString()))
+		}
+		seen[zone.ValueString()] = true
+	}
+
+	if !model.AlertThreshold.IsNull() && !model.AlertThreshold.IsUnknown() && model.AlertThreshold.ValueInt64() > int64(len(zones)) {
+		diags.AddAttributeError(block.AtName("alert_threshold"), "Invalid alert_threshold",
+			fmt.Sprintf("alert_threshold is %d but only %d zones are queried, so the check could never fail.", model.AlertThreshold.ValueInt64(), len(zones)))
+	}
+	return diags
+}
+
+func renderBlacklistCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model blacklistCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setStringList(ctx, doc, "zones", model.Zones, &diags)
+	setInt64(doc, "alert_threshold", model.AlertThreshold)
+	return doc, diags
+}
@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// typedCheckConfig is a nested block on tinymon_check that replaces the raw
+// JSON config for one check type. The block is rendered into the config
+// attribute at plan time, so config in state always holds what the server
+// receives.
+type typedCheckConfig struct {
+	// name is the block name, e.g. "dns_config".
+	name string
+	// checkType is the value the check's type attribute must have.
+	checkType string
+	block     schema.SingleNestedBlock
+	// required lists block attributes that must be set when the block is
+	// present. They are declared Optional in the schema because the framework
+	// enforces Required attributes of a single nested block even when the
+	// block itself is absent.
+	required []string
+	// unrendered lists block attributes that are not part of the rendered
+	// config, i.e. write-only secrets and their version. Unknown values in
+	// them do not make the config unknown.
+	unrendered []string
+	// validate optionally checks constraints spanning several attributes.
+	// It may see unknown values.
+	validate func(ctx context.Context, obj types.Object) diag.Diagnostics
+	// render builds the JSON config document from the configured block.
+	render func(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics)
+	// secrets optionally builds the write-only part of the config from the
+	// block in the configuration. It is sent as the request's secrets, which
+	// the server merges into the config but never returns.
+	secrets func(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics)
+}
+
+// typedCheckConfigs lists all typed config blocks of tinymon_check.
+var typedCheckConfigs = []typedCheckConfig{
+	dnsCheckConfig,
+	portCheckConfig,
+	certificateCheckConfig,
+	pingCheckConfig,
+	httpCheckConfig,
+	snmpCheckConfig,
+	jsonAPICheckConfig,
+	smtpCheckConfig,
+	imapCheckConfig,
+	pop3CheckConfig,
+	sshCheckConfig,
+	databaseCheckConfig,
+	redisCheckConfig,
+	ntpCheckConfig,
+	domainExpiryCheckConfig,
+	blacklistCheckConfig,
+	grpcCheckConfig,
+	websocketCheckConfig,
+	mqttCheckConfig,
+	ldapCheckConfig,
+	diskCheckConfig,
+	loadCheckConfig,
+	memoryCheckConfig,
+	processCheckConfig,
+	logCheckConfig,
+	scriptCheckConfig,
+}
+
+func typedCheckConfigBlocks() map[string]schema.Block {
+	blocks := make(map[string]schema.Block, len(typedCheckConfigs))
+	for _, tc := range typedCheckConfigs {
+		blocks[tc.name] = tc.block
+	}
+	return blocks
+}
+
+// validateTypedCheckConfigs ensures at most one typed block is set, that it
+// matches the check type and that it is not combined with a raw config.
+func validateTypedCheckConfigs(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var checkType, rawConfig types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("type"), &checkType)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("config"), &rawConfig)...)
+	if diags.HasError() {
+		return
+	}
+
+	var configured []string
+	for _, tc := range typedCheckConfigs {
+		var obj types.Object
+		diags.Append(config.GetAttribute(ctx, path.Root(tc.name), &obj)...)
+		if diags.HasError() {
+			return
+		}
+		if obj.IsNull() {
+			continue
+		}
+		configured = append(configured, tc.name)
+
+		if !checkType.IsNull() && !checkType.IsUnknown() && checkType.ValueString() != tc.checkType {
+			diags.AddAttributeError(path.Root(tc.name), "Config block does not match check type",
+				fmt.Sprintf("%s can only be used with type = %q, got %q.", tc.name, tc.checkType, checkType.ValueString()))
+		}
+		if !rawConfig.IsNull() {
+			diags.AddAttributeError(path.Root(tc.name), "Conflicting check config",
+				fmt.Sprintf("config and %s cannot both be set.", tc.name))
+		}
+		if !obj.IsUnknown() {
+			attrs := obj.Attributes()
+			for _, name := range tc.required {
+				if value, ok := attrs[name]; ok && value.IsNull() {
+					diags.AddAttributeError(path.Root(tc.name).AtName(name), "Missing required argument",
+						fmt.Sprintf("The argument %q is required in %s.", name, tc.name))
+				}
+			}
+		}
+		if tc.validate != nil && !obj.IsUnknown() {
+			diags.Append(tc.validate(ctx, obj)...)
+		}
+	}
+
+	if len(configured) > 1 {
+		diags.AddError("Conflicting check config",
+			fmt.Sprintf("Only one typed config block may be set, got: %v.", configured))
+	}
+}
+
+var _ planmodifier.String = typedCheckConfigModifier{}
+
+// typedCheckConfigModifier sets the planned config to the rendered typed
+// block. It must run before RequiresReplace so replacement is decided on the
+// rendered value.
+type typedCheckConfigModifier struct{}
+
+func (m typedCheckConfigModifier) Description(_ context.Context) string {
+	return "Renders the typed config block into the JSON config."
+}
+
+func (m typedCheckConfigModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m typedCheckConfigModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	for _, tc := range typedCheckConfigs {
+		var obj types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(tc.name), &obj)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if obj.IsNull() {
+			continue
+		}
+		if tc.renderedUnknown(obj) {
+			resp.PlanValue = types.StringUnknown()
+			return
+		}
+
+		doc, diags := tc.render(ctx, obj)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// encoding/json sorts map keys, which keeps the rendered config stable.
+		data, err := json.Marshal(doc)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(tc.name), "Error rendering check config", err.Error())
+			return
+		}
+		resp.PlanValue = types.StringValue(string(data))
+		return
+	}
+}
+
+// renderedUnknown reports whether an attribute rendered into the config is
+// unknown.
+func (tc typedCheckConfig) renderedUnknown(obj types.Object) bool {
+	if obj.IsUnknown() {
+		return true
+	}
+	for name, value := range obj.Attributes() {
+		if !slices.Contains(tc.unrendered, name) && containsUnknown(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// typedCheckConfigSecrets returns the write-only secrets of the configured
+// typed block, or nil if there are none. Write-only values are only available
+// in the configuration, not in the plan.
+func typedCheckConfigSecrets(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) map[string]interface{} {
+	for _, tc := range typedCheckConfigs {
+		if tc.secrets == nil {
+			continue
+		}
+		var obj types.Object
+		diags.Append(config.GetAttribute(ctx, path.Root(tc.name), &obj)...)
+		if diags.HasError() || obj.IsNull() {
+			continue
+		}
+		secrets, d := tc.secrets(ctx, obj)
+		diags.Append(d...)
+		if len(secrets) == 0 {
+			return nil
+		}
+		return secrets
+	}
+	return nil
+}
+
+// containsUnknown reports whether v or any nested value is unknown.
+func containsUnknown(v attr.Value) bool {
+	if v.IsUnknown() {
+		return true
+	}
+	switch value := v.(type) {
+	case basetypes.ObjectValue:
+		for _, inner := range value.Attributes() {
+			if containsUnknown(inner) {
+				return true
+			}
+		}
+	case basetypes.ListValue:
+		for _, inner := range value.Elements() {
+			if containsUnknown(inner) {
+				return true
+			}
+		}
+	case basetypes.SetValue:
+		for _, inner := range value.Elements() {
+			if containsUnknown(inner) {
+				return true
+			}
+		}
+	case basetypes.MapValue:
+		for _, inner := range value.Elements() {
+			if containsUnknown(inner) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// comparisonOperators compare a value measured by a check with an expected
+// value. lt, le, gt and ge compare numbers, regex uses RE2 syntax.
+var comparisonOperators = []string{"eq", "ne", "lt", "le", "gt", "ge", "contains", "regex"}
+
+// validateComparison checks that expected suits operator. expectedPath points
+// at the expected value for the diagnostics.
+func validateComparison(diags *diag.Diagnostics, expectedPath path.Path, operator, expected types.String) {
+	if operator.IsNull() || operator.IsUnknown() || expected.IsNull() || expected.IsUnknown() {
+		return
+	}
+	switch operator.ValueString() {
+	case "lt", "le", "gt", "ge":
+		if _, err := strconv.ParseFloat(expected.ValueString(), 64); err != nil {
+			diags.AddAttributeError(expectedPath, "Invalid expected value",
+				fmt.Sprintf("operator %q compares numbers, got %q.", operator.ValueString(), expected.ValueString()))
+		}
+	case "regex":
+		if _, err := regexp.Compile(expected.ValueString()); err != nil {
+			diags.AddAttributeError(expectedPath, "Invalid regular expression", err.Error())
+		}
+	}
+}
+
+// validateCheckURL rejects URLs that are not absolute http or https URLs.
+func validateCheckURL(diags *diag.Diagnostics, urlPath path.Path, v types.String) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	parsed, err := url.Parse(v.ValueString())
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		diags.AddAttributeError(urlPath, "Invalid URL",
+			fmt.Sprintf("url must be an absolute http or https URL, got %q.", v.ValueString()))
+	}
+}
+
+// The set* helpers copy optional block attributes into a config document,
+// leaving null attributes out so the server applies its own defaults.
+
+func setString(doc map[string]interface{}, key string, v types.String) {
+	if !v.IsNull() {
+		doc[key] = v.ValueString()
+	}
+}
+
+func setInt64(doc map[string]interface{}, key string, v types.Int64) {
+	if !v.IsNull() {
+		doc[key] = v.ValueInt64()
+	}
+}
+
+func setFloat64(doc map[string]interface{}, key string, v types.Float64) {
+	if !v.IsNull() {
+		doc[key] = v.ValueFloat64()
+	}
+}
+
+func setBool(doc map[string]interface{}, key string, v types.Bool) {
+	if !v.IsNull() {
+		doc[key] = v.ValueBool()
+	}
+}
+
+func setStringList(ctx context.Context, doc map[string]interface{}, key string, v types.List, diags *diag.Diagnostics) {
+	if v.IsNull() {
+		return
+	}
+	values := []string{}
+	diags.Append(v.ElementsAs(ctx, &values, false)...)
+	doc[key] = values
+}
+
+func setStringMap(ctx context.Context, doc map[string]interface{}, key string, v types.Map, diags *diag.Diagnostics) {
+	if v.IsNull() {
+		return
+	}
+	values := map[string]string{}
+	diags.Append(v.ElementsAs(ctx, &values, false)...)
+	doc[key] = values
+}
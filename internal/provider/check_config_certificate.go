@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var certificateCheckConfig = typedCheckConfig{
+	name:      "certificate_config",
+	checkType: "certificate",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for certificate expiry checks. Replaces config.",
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				Description: "TLS port. Defaults to 443 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 65535),
+				},
+			},
+			"sni_hostname": schema.StringAttribute{
+				Description: "Hostname sent via SNI and matched against the certificate. Defaults to the host address.",
+				Optional:    true,
+			},
+			"warn_days_before_expiry": schema.Int64Attribute{
+				Description: "Number of days before expiry at which the check starts warning.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 365),
+				},
+			},
+			"check_chain": schema.BoolAttribute{
+				Description: "Also validate the full certificate chain.",
+				Optional:    true,
+			},
+		},
+	},
+	render: renderCertificateCheckConfig,
+}
+
+type certificateCheckConfigModel struct {
+	Port                 types.Int64  `tfsdk:"port"`
+	SNIHostname          types.String `tfsdk:"sni_hostname"`
+	WarnDaysBeforeExpiry types.Int64  `tfsdk:"warn_days_before_expiry"`
+	CheckChain           types.Bool   `tfsdk:"check_chain"`
+}
+
+func renderCertificateCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model certificateCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setInt64(doc, "port", model.Port)
+	setString(doc, "sni_hostname", model.SNIHostname)
+	setInt64(doc, "warn_days_before_expiry", model.WarnDaysBeforeExpiry)
+	setBool(doc, "check_chain", model.CheckChain)
+	return doc, diags
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// postgresDSNPassword finds a password in a key/value Postgres connection
+// string such as "host=db user=monitor password=secret".
+var postgresDSNPassword = regexp.MustCompile(`(^|\s)password\s*=`)
+
+var databaseCheckConfig = typedCheckConfig{
+	name:      "database_config",
+	checkType: "database",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for database checks. Replaces config. The check connects, runs query and compares the first column of the first row. The password is write-only and never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"engine": schema.StringAttribute{
+				Description: "Database engine (mysql, postgres). Required.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("mysql", "postgres"),
+				},
+			},
+			"dsn": schema.StringAttribute{
+				Description: "Connection string without the password, e.g. postgres://monitor@db:5432/app?sslmode=require or monitor@tcp(db:3306)/app for mysql. Required.",
+				Optional:    true,
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "Password for the user in dsn. Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				Description: "Terraform cannot detect changes to write-only values. Change this number to send password_wo again.",
+				Optional:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "Query to run. Defaults to SELECT 1 on the server.",
+				Optional:    true,
+			},
+			"expected_result": schema.StringAttribute{
+				Description: "Value the first column of the first row is compared with. If unset, any successful query passes.",
+				Optional:    true,
+			},
+			"operator": schema.StringAttribute{
+				Description: "Comparison of the result with expected_result (eq, ne, lt, le, gt, ge, contains, regex). Defaults to eq on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf(comparisonOperators...),
+				},
+			},
+			"max_query_time_ms": schema.Int64Attribute{
+				Description: "The check fails if connecting and running query takes longer.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 600000),
+				},
+			},
+		},
+	},
+	required:   []string{"engine", "dsn"},
+	unrendered: []string{"password_wo", "credentials_wo_version"},
+	validate:   validateDatabaseCheckConfig,
+	render:     renderDatabaseCheckConfig,
+	secrets:    databaseCheckConfigSecrets,
+}
+
+type databaseCheckConfigModel struct {
+	Engine               types.String `tfsdk:"engine"`
+	DSN                  types.String `tfsdk:"dsn"`
+	PasswordWO           types.String `tfsdk:"password_wo"`
+	CredentialsWOVersion types.Int64  `tfsdk:"credentials_wo_version"`
+	Query                types.String `tfsdk:"query"`
+	ExpectedResult       types.String `tfsdk:"expected_result"`
+	Operator             types.String `tfsdk:"operator"`
+	MaxQueryTimeMS       types.Int64  `tfsdk:"max_query_time_ms"`
+}
+
+func validateDatabaseCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model databaseCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	block := path.Root("database_config")
+
+	if !model.Operator.IsNull() && model.ExpectedResult.IsNull() {
+		diags.AddAttributeError(block.AtName("operator"), "Missing expected_result",
+			"operator compares the result with expected_result and needs it.")
+	}
+	validateComparison(&diags, block.AtName("expected_result"), model.Operator, model.ExpectedResult)
+
+	if model.Engine.IsNull() || model.Engine.IsUnknown() || model.DSN.IsNull() || model.DSN.IsUnknown() {
+		return diags
+	}
+	if dsnHasPassword(model.Engine.ValueString(), model.DSN.ValueString()) {
+		diags.AddAttributeError(block.AtName("dsn"), "Password in DSN",
+			"dsn is stored in state and must not contain the password. Set password_wo instead.")
+	}
+	return diags
+}
+
+// dsnHasPassword reports whether dsn embeds a password. Postgres accepts URLs
+// and key/value strings, mysql the user:password@protocol(address)/db form.
+func dsnHasPassword(engine, dsn string) bool {
+	if engine == "postgres" {
+		if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+			parsed, err := url.Parse(dsn)
+			if err != nil || parsed.User == nil {
+				return false
+			}
+			_, set := parsed.User.Password()
+			return set
+		}
+		return postgresDSNPassword.MatchString(dsn)
+	}
+	at := strings.Index(dsn, "@")
+	return at >= 0 && strings.Contains(dsn[:at], ":")
+}
+
+func renderDatabaseCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model databaseCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setString(doc, "engine", model.Engine)
+	setString(doc, "dsn", model.DSN)
+	setString(doc, "query", model.Query)
+	setString(doc, "expected_result", model.ExpectedResult)
+	setString(doc, "operator", model.Operator)
+	setInt64(doc, "max_query_time_ms", model.MaxQueryTimeMS)
+	return doc, diags
+}
+
+func databaseCheckConfigSecrets(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model databaseCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	secrets := map[string]interface{}{}
+	setString(secrets, "password", model.PasswordWO)
+	return secrets, diags
+}
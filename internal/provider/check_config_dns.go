@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var dnsCheckConfig = typedCheckConfig{
+	name:      "dns_config",
+	checkType: "dns",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for dns checks. Replaces config.",
+		Attributes: map[string]schema.Attribute{
+			"record_type": schema.StringAttribute{
+				Description: "Record type to query (A, AAAA, CNAME, MX, NS, TXT, SRV, CAA, PTR, SOA). Required.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("A", "AAAA", "CNAME", "MX", "NS", "TXT", "SRV", "CAA", "PTR", "SOA"),
+				},
+			},
+			"expected_values": schema.ListAttribute{
+				Description: "Values the answer must contain. If unset, any answer is accepted.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"resolver": schema.StringAttribute{
+				Description: "Resolver to query, e.g. 1.1.1.1 or 10.0.0.53:53. Defaults to the server's resolver.",
+				Optional:    true,
+			},
+			"dnssec": schema.BoolAttribute{
+				Description: "Require a DNSSEC-validated answer.",
+				Optional:    true,
+			},
+		},
+	},
+	required: []string{"record_type"},
+	render:   renderDNSCheckConfig,
+}
+
+type dnsCheckConfigModel struct {
+	RecordType     types.String `tfsdk:"record_type"`
+	ExpectedValues types.List   `tfsdk:"expected_values"`
+	Resolver       types.String `tfsdk:"resolver"`
+	DNSSEC         types.Bool   `tfsdk:"dnssec"`
+}
+
+func renderDNSCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model dnsCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setString(doc, "record_type", model.RecordType)
+	setStringList(ctx, doc, "expected_values", model.ExpectedValues, &diags)
+	setString(doc, "resolver", model.Resolver)
+	setBool(doc, "dnssec", model.DNSSEC)
+	return doc, diags
+}
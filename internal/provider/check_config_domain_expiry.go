@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// domainName matches fully qualified domain names with at least two labels,
+// e.g. example.com or example.co.uk, without a trailing dot.
+var domainName = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$`)
+
+var domainExpiryCheckConfig = typedCheckConfig{
+	name:      "domain_expiry_config",
+	checkType: "domain_expiry",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for domain_expiry checks. Replaces config. The registration is looked up via RDAP, falling back to WHOIS.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Description: "Registered domain to look up, e.g. example.com. Defaults to the host address.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringMatches(domainName, "a domain name such as example.com"),
+				},
+			},
+			"warn_days_before_expiry": schema.Int64Attribute{
+				Description: "Number of days before expiry at which the check starts warning. Defaults to 30 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 365),
+				},
+			},
+			"expected_registrar": schema.StringAttribute{
+				Description: "Text the registrar name must contain, case-insensitively. Catches unexpected registrar transfers.",
+				Optional:    true,
+			},
+		},
+	},
+	render: renderDomainExpiryCheckConfig,
+}
+
+type domainExpiryCheckConfigModel struct {
+	Domain               types.String `tfsdk:"domain"`
+	WarnDaysBeforeExpiry types.Int64  `tfsdk:"warn_days_before_expiry"`
+	ExpectedRegistrar    types.String `tfsdk:"expected_registrar"`
+}
+
+func renderDomainExpiryCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model domainExpiryCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setString(doc, "domain", model.Domain)
+	setInt64(doc, "warn_days_before_expiry", model.WarnDaysBeforeExpiry)
+	setString(doc, "expected_registrar", model.ExpectedRegistrar)
+	return doc, diags
+}
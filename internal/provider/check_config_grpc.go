@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var grpcCheckConfig = typedCheckConfig{
+	name:      "grpc_config",
+	checkType: "grpc",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for grpc checks. Replaces config. The check calls grpc.health.v1.Health/Check and passes if the status is SERVING.",
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				Description: "TCP port of the gRPC server. Required.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 65535),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "Service name sent in the health request, e.g. orders.v1.OrderService. If unset, the server's overall health is checked.",
+				Optional:    true,
+			},
+			"use_tls": schema.BoolAttribute{
+				Description: "Connect with TLS. Defaults to false on the server.",
+				Optional:    true,
+			},
+			"sni_hostname": schema.StringAttribute{
+				Description: "Hostname sent via SNI and matched against the certificate. Defaults to the host address. Needs use_tls.",
+				Optional:    true,
+			},
+			"ca_certificate": schema.StringAttribute{
+				Description: "PEM-encoded CA certificate the server certificate must chain to, for private CAs. Defaults to the system roots. Needs use_tls.",
+				Optional:    true,
+			},
+			"skip_tls_verify": schema.BoolAttribute{
+				Description: "Accept any server certificate. Needs use_tls.",
+				Optional:    true,
+			},
+			"deadline_ms": schema.Int64Attribute{
+				Description: "Deadline of the health call. Defaults to 5000 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 60000),
+				},
+			},
+		},
+	},
+	required: []string{"port"},
+	validate: validateGRPCCheckConfig,
+	render:   renderGRPCCheckConfig,
+}
+
+type grpcCheckConfigModel struct {
+	Port          types.Int64  `tfsdk:"port"`
+	Service       types.String `tfsdk:"service"`
+	UseTLS        types.Bool   `tfsdk:"use_tls"`
+	SNIHostname   types.String `tfsdk:"sni_hostname"`
+	CACertificate types.String `tfsdk:"ca_certificate"`
+	SkipTLSVerify types.Bool   `tfsdk:"skip_tls_verify"`
+	DeadlineMS    types.Int64  `tfsdk:"deadline_ms"`
+}
+
+func validateGRPCCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model grpcCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	block := path.Root("grpc_config")
+
+	if !model.CACertificate.IsNull() && !model.CACertificate.IsUnknown() &&
+		!strings.Contains(model.CACertificate.ValueString(), "-----BEGIN CERTIFICATE-----") {
+		diags.AddAttributeError(block.AtName("ca_certificate"), "Invalid CA certificate",
+			"ca_certificate must be a PEM-encoded certificate, e.g. file(\"ca.pem\").")
+	}
+	if !model.CACertificate.IsNull() && model.SkipTLSVerify.ValueBool() {
+		diags.AddAttributeError(block.AtName("skip_tls_verify"), "Invalid attribute combination",
+			"ca_certificate has no effect when skip_tls_verify is true; set only one of them.")
+	}
+
+	if model.UseTLS.IsUnknown() || model.UseTLS.ValueBool() {
+		return diags
+	}
+	tlsAttributes := []struct {
+		name  string
+		value attr.Value
+	}{
+		{"sni_hostname", model.SNIHostname},
+		{"ca_certificate", model.CACertificate},
+		{"skip_tls_verify", model.SkipTLSVerify},
+	}
+	for _, a := range tlsAttributes {
+		if !a.value.IsNull() {
+			diags.AddAttributeError(block.AtName(a.name), "Invalid attribute combination",
+				fmt.Sprintf("%s can only be set when use_tls is true.", a.name))
+		}
+	}
+	return diags
+}
+
+func renderGRPCCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model grpcCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setInt64(doc, "port", model.Port)
+	setString(doc, "service", model.Service)
+	setBool(doc, "use_tls", model.UseTLS)
+	setString(doc, "sni_hostname", model.SNIHostname)
+	setString(doc, "ca_certificate", model.CACertificate)
+	setBool(doc, "skip_tls_verify", model.SkipTLSVerify)
+	setInt64(doc, "deadline_ms", model.DeadlineMS)
+	return doc, diags
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var httpCheckConfig = typedCheckConfig{
+	name:      "http_config",
+	checkType: "http",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for http checks. Replaces config. Credentials are write-only and never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Description: "URL to request. Must use http or https. Required.",
+				Optional:    true,
+			},
+			"method": schema.StringAttribute{
+				Description: "Request method. Defaults to GET on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("GET", "HEAD", "POST", "PUT", "OPTIONS"),
+				},
+			},
+			"expected_status": schema.Int64Attribute{
+				Description: "Expected HTTP status code. Defaults to any 2xx on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(100, 599),
+				},
+			},
+			"keyword": schema.StringAttribute{
+				Description: "Text that must appear in the response body.",
+				Optional:    true,
+			},
+			"keyword_absent": schema.StringAttribute{
+				Description: "Text that must not appear in the response body, e.g. an error page marker.",
+				Optional:    true,
+			},
+			"case_sensitive": schema.BoolAttribute{
+				Description: "Match keyword and keyword_absent case-sensitively. Defaults to true on the server.",
+				Optional:    true,
+			},
+			"regex_match": schema.StringAttribute{
+				Description: "Regular expression (RE2 syntax) the response body must match.",
+				Optional:    true,
+				Validators: []validator.String{
+					regularExpression(),
+				},
+			},
+			"invert_regex_match": schema.BoolAttribute{
+				Description: "Fail when the response body matches regex_match instead of when it does not.",
+				Optional:    true,
+			},
+			"follow_redirects": schema.BoolAttribute{
+				Description: "Follow redirects instead of checking the redirect response.",
+				Optional:    true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "Request headers. Use headers_wo for headers carrying credentials.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Basic auth user name.",
+				Optional:    true,
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "Basic auth password. Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"headers_wo": schema.MapAttribute{
+				Description: "Request headers carrying credentials, e.g. Authorization. Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				Description: "Terraform cannot detect changes to write-only values. Change this number to send password_wo and headers_wo again.",
+				Optional:    true,
+			},
+		},
+	},
+	required:   []string{"url"},
+	unrendered: []string{"password_wo", "headers_wo", "credentials_wo_version"},
+	validate:   validateHTTPCheckConfig,
+	render:     renderHTTPCheckConfig,
+	secrets:    httpCheckConfigSecrets,
+}
+
+type httpCheckConfigModel struct {
+	URL                  types.String `tfsdk:"url"`
+	Method               types.String `tfsdk:"method"`
+	ExpectedStatus       types.Int64  `tfsdk:"expected_status"`
+	Keyword              types.String `tfsdk:"keyword"`
+	KeywordAbsent        types.String `tfsdk:"keyword_absent"`
+	CaseSensitive        types.Bool   `tfsdk:"case_sensitive"`
+	RegexMatch           types.String `tfsdk:"regex_match"`
+	InvertRegexMatch     types.Bool   `tfsdk:"invert_regex_match"`
+	FollowRedirects      types.Bool   `tfsdk:"follow_redirects"`
+	Headers              types.Map    `tfsdk:"headers"`
+	Username             types.String `tfsdk:"username"`
+	PasswordWO           types.String `tfsdk:"password_wo"`
+	HeadersWO            types.Map    `tfsdk:"headers_wo"`
+	CredentialsWOVersion types.Int64  `tfsdk:"credentials_wo_version"`
+}
+
+func validateHTTPCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model httpCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+
+	validateCheckURL(&diags, path.Root("http_config").AtName("url"), model.URL)
+	if !model.PasswordWO.IsNull() && model.Username.IsNull() {
+		diags.AddAttributeError(path.Root("http_config").AtName("password_wo"), "Missing username",
+			"password_wo is the basic auth password and needs username.")
+	}
+	if !model.CaseSensitive.IsNull() && model.Keyword.IsNull() && model.KeywordAbsent.IsNull() {
+		diags.AddAttributeError(path.Root("http_config").AtName("case_sensitive"), "Missing keyword",
+			"case_sensitive applies to keyword and keyword_absent and needs one of them.")
+	}
+	if !model.InvertRegexMatch.IsNull() && model.RegexMatch.IsNull() {
+		diags.AddAttributeError(path.Root("http_config").AtName("invert_regex_match"), "Missing regex_match",
+			"invert_regex_match inverts regex_match and needs it.")
+	}
+	return diags
+}
+
+func renderHTTPCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model httpCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setString(doc, "url", model.URL)
+	setString(doc, "method", model.Method)
+	setInt64(doc, "expected_status", model.ExpectedStatus)
+	setString(doc, "keyword", model.Keyword)
+	setString(doc, "keyword_absent", model.KeywordAbsent)
+	setBool(doc, "case_sensitive", model.CaseSensitive)
+	setString(doc, "regex_match", model.RegexMatch)
+	setBool(doc, "invert_regex_match", model.InvertRegexMatch)
+	setBool(doc, "follow_redirects", model.FollowRedirects)
+	setStringMap(ctx, doc, "headers", model.Headers, &diags)
+	setString(doc, "username", model.Username)
+	return doc, diags
+}
+
+func httpCheckConfigSecrets(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model httpCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	secrets := map[string]interface{}{}
+	setString(secrets, "password", model.PasswordWO)
+	setStringMap(ctx, secrets, "headers", model.HeadersWO, &diags)
+	return secrets, diags
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// jsonPath matches the JSONPath subset the server evaluates: dot-notation
+// children, ['quoted'] children, [n] indexes and [*] wildcards below $.
+var jsonPath = regexp.MustCompile(`^\$(\.[A-Za-z_][A-Za-z0-9_-]*|\['[^']+'\]|\[[0-9]+\]|\[\*\])*$`)
+
+// jsonAssertionOperators adds existence tests, which take no value, to the
+// comparison operators.
+var jsonAssertionOperators = slices.Concat(comparisonOperators, []string{"exists", "not_exists"})
+
+var jsonAPICheckConfig = typedCheckConfig{
+	name:      "json_api_config",
+	checkType: "json_api",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for json_api checks. Replaces config. The response is parsed as JSON and every assertion must hold. Credential headers are write-only and never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Description: "URL to request. Must use http or https. Required.",
+				Optional:    true,
+			},
+			"method": schema.StringAttribute{
+				Description: "Request method. Defaults to GET on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("GET", "POST", "PUT"),
+				},
+			},
+			"request_body": schema.StringAttribute{
+				Description: "Request body, e.g. from jsonencode(). Sent with Content-Type application/json.",
+				Optional:    true,
+			},
+			"expected_status": schema.Int64Attribute{
+				Description: "Expected HTTP status code. Defaults to any 2xx on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(100, 599),
+				},
+			},
+			"headers": schema.MapAttribute{
+				Description: "Request headers. Use headers_wo for headers carrying credentials.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"headers_wo": schema.MapAttribute{
+				Description: "Request headers carrying credentials, e.g. Authorization. Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				Description: "Terraform cannot detect changes to write-only values. Change this number to send headers_wo again.",
+				Optional:    true,
+			},
+			"assertions": schema.ListNestedAttribute{
+				Description: "Assertions on the response document, evaluated in order. Required.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Description: "JSONPath of the value, e.g. $.status or $.queues[0].depth.",
+							Required:    true,
+							Validators: []validator.String{
+								stringMatches(jsonPath, "a JSONPath starting with $ using .name, ['name'], [n] or [*]"),
+							},
+						},
+						"operator": schema.StringAttribute{
+							Description: "Comparison with value (eq, ne, lt, le, gt, ge, contains, regex), or exists / not_exists.",
+							Required:    true,
+							Validators: []validator.String{
+								stringOneOf(jsonAssertionOperators...),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "Expected value. JSON strings are compared without quotes, e.g. \"ok\" matches \"ok\". Not used by exists and not_exists.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	},
+	required:   []string{"url", "assertions"},
+	unrendered: []string{"headers_wo", "credentials_wo_version"},
+	validate:   validateJSONAPICheckConfig,
+	render:     renderJSONAPICheckConfig,
+	secrets:    jsonAPICheckConfigSecrets,
+}
+
+type jsonAPICheckConfigModel struct {
+	URL                  types.String `tfsdk:"url"`
+	Method               types.String `tfsdk:"method"`
+	RequestBody          types.String `tfsdk:"request_body"`
+	ExpectedStatus       types.Int64  `tfsdk:"expected_status"`
+	Headers              types.Map    `tfsdk:"headers"`
+	HeadersWO            types.Map    `tfsdk:"headers_wo"`
+	CredentialsWOVersion types.Int64  `tfsdk:"credentials_wo_version"`
+	Assertions           types.List   `tfsdk:"assertions"`
+}
+
+type jsonAssertionModel struct {
+	Path     types.String `tfsdk:"path"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+func validateJSONAPICheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model jsonAPICheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	block := path.Root("json_api_config")
+
+	validateCheckURL(&diags, block.AtName("url"), model.URL)
+	if !model.RequestBody.IsNull() && !model.Method.IsUnknown() && (model.Method.IsNull() || model.Method.ValueString() == "GET") {
+		diags.AddAttributeError(block.AtName("request_body"), "Invalid attribute combination",
+			"request_body needs method POST or PUT.")
+	}
+
+	if model.Assertions.IsNull() || model.Assertions.IsUnknown() {
+		return diags
+	}
+	if len(model.Assertions.Elements()) == 0 {
+		diags.AddAttributeError(block.AtName("assertions"), "Missing assertions",
+			"At least one assertion is required.")
+		return diags
+	}
+	var assertions []jsonAssertionModel
+	if d := model.Assertions.ElementsAs(ctx, &assertions, false); d.HasError() {
+		diags.Append(d...)
+		return diags
+	}
+	for i, assertion := range assertions {
+		assertionPath := block.AtName("assertions").AtListIndex(i)
+		if assertion.Operator.IsUnknown() {
+			continue
+		}
+		switch assertion.Operator.ValueString() {
+		case "exists", "not_exists":
+			if !assertion.Value.IsNull() {
+				diags.AddAttributeError(assertionPath.AtName("value"), "Invalid attribute combination",
+					fmt.Sprintf("operator %q takes no value.", assertion.Operator.ValueString()))
+			}
+		default:
+			if assertion.Value.IsNull() {
+				diags.AddAttributeError(assertionPath.AtName("value"), "Missing required argument",
+					fmt.Sprintf("operator %q compares with value and needs it.", assertion.Operator.ValueString()))
+			}
+			validateComparison(&diags, assertionPath.AtName("value"), assertion.Operator, assertion.Value)
+		}
+	}
+	return diags
+}
+
+func renderJSONAPICheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model jsonAPICheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setString(doc, "url", model.URL)
+	setString(doc, "method", model.Method)
+	setString(doc, "request_body", model.RequestBody)
+	setInt64(doc, "expected_status", model.ExpectedStatus)
+	setStringMap(ctx, doc, "headers", model.Headers, &diags)
+
+	if !model.Assertions.IsNull() {
+		var assertions []jsonAssertionModel
+		diags.Append(model.Assertions.ElementsAs(ctx, &assertions, false)...)
+		rendered := make([]map[string]interface{}, 0, len(assertions))
+		for _, assertion := range assertions {
+			entry := map[string]interface{}{}
+			setString(entry, "path", assertion.Path)
+			setString(entry, "operator", assertion.Operator)
+			setString(entry, "value", assertion.Value)
+			rendered = append(rendered, entry)
+		}
+		doc["assertions"] = rendered
+	}
+	return doc, diags
+}
+
+func jsonAPICheckConfigSecrets(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model jsonAPICheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	secrets := map[string]interface{}{}
+	setStringMap(ctx, secrets, "headers", model.HeadersWO, &diags)
+	return secrets, diags
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var ldapCheckConfig = typedCheckConfig{
+	name:      "ldap_config",
+	checkType: "ldap",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for ldap checks. Replaces config. The check binds, searches below base_dn and counts the entries found. The bind password is write-only and never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				Description: "TCP port. Defaults to 636 with encryption tls and 389 otherwise on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 65535),
+				},
+			},
+			"encryption": schema.StringAttribute{
+				Description: "Transport security (none, starttls, tls). Defaults to none on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("none", "starttls", "tls"),
+				},
+			},
+			"bind_dn": schema.StringAttribute{
+				Description: "DN to bind as, e.g. cn=monitoring,ou=services,dc=example,dc=com. Needs password_wo. If unset, the check binds anonymously.",
+				Optional:    true,
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "Bind password. Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				Description: "Terraform cannot detect changes to write-only values. Change this number to send password_wo again.",
+				Optional:    true,
+			},
+			"base_dn": schema.StringAttribute{
+				Description: "DN to search below, e.g. ou=people,dc=example,dc=com. Required.",
+				Optional:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "Search filter, e.g. (objectClass=person). Defaults to (objectClass=*) on the server.",
+				Optional:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "Search scope (base, one, sub). Defaults to sub on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("base", "one", "sub"),
+				},
+			},
+			"min_entries": schema.Int64Attribute{
+				Description: "The check fails if fewer entries are found. Defaults to 1 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(0, 1000000),
+				},
+			},
+			"max_entries": schema.Int64Attribute{
+				Description: "The check fails if more entries are found.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(0, 1000000),
+				},
+			},
+		},
+	},
+	required:   []string{"base_dn"},
+	unrendered: []string{"password_wo", "credentials_wo_version"},
+	validate:   validateLDAPCheckConfig,
+	render:     renderLDAPCheckConfig,
+	secrets:    ldapCheckConfigSecrets,
+}
+
+type ldapCheckConfigModel struct {
+	Port                 types.Int64  `tfsdk:"port"`
+	Encryption           types.String `tfsdk:"encryption"`
+	BindDN               types.String `tfsdk:"bind_dn"`
+	PasswordWO           types.String `tfsdk:"password_wo"`
+	CredentialsWOVersion types.Int64  `tfsdk:"credentials_wo_version"`
+	BaseDN               types.String `tfsdk:"base_dn"`
+	Filter               types.String `tfsdk:"filter"`
+	Scope                types.String `tfsdk:"scope"`
+	MinEntries           types.Int64  `tfsdk:"min_entries"`
+	MaxEntries           types.Int64  `tfsdk:"max_entries"`
+}
+
+func validateLDAPCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model ldapCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	block := path.Root("ldap_config")
+
+	// A simple bind with a DN but an empty password is an unauthenticated
+	// bind, which servers accept without checking anything.
+	if !model.BindDN.IsNull() && model.PasswordWO.IsNull() {
+		diags.AddAttributeError(block.AtName("password_wo"), "Missing required argument",
+			"bind_dn needs password_wo; a bind without a password is not authenticated.")
+	}
+	if !model.PasswordWO.IsNull() && model.BindDN.IsNull() {
+		diags.AddAttributeError(block.AtName("bind_dn"), "Missing required argument",
+			"password_wo is the bind password and needs bind_dn.")
+	}
+	validateLDAPDN(&diags, block.AtName("bind_dn"), model.BindDN)
+	validateLDAPDN(&diags, block.AtName("base_dn"), model.BaseDN)
+
+	if !model.Filter.IsNull() && !model.Filter.IsUnknown() && !ldapFilterBalanced(model.Filter.ValueString()) {
+		diags.AddAttributeError(block.AtName("filter"), "Invalid filter",
+			fmt.Sprintf("filter must be enclosed in balanced parentheses, e.g. (objectClass=person), got %q.", model.Filter.ValueString()))
+	}
+
+	if !model.MinEntries.IsNull() && !model.MinEntries.IsUnknown() && !model.MaxEntries.IsNull() && !model.MaxEntries.IsUnknown() &&
+		model.MinEntries.ValueInt64() > model.MaxEntries.ValueInt64() {
+		diags.AddAttributeError(block.AtName("max_entries"), "Invalid entry range",
+			fmt.Sprintf("max_entries (%d) must not be less than min_entries (%d).", model.MaxEntries.ValueInt64(), model.MinEntries.ValueInt64()))
+	}
+	return diags
+}
+
+// validateLDAPDN rejects values that cannot be distinguished names because
+// they lack an attribute=value pair.
+func validateLDAPDN(diags *diag.Diagnostics, dnPath path.Path, v types.String) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	if !strings.Contains(v.ValueString(), "=") {
+		diags.AddAttributeError(dnPath, "Invalid DN",
+			fmt.Sprintf("expected a distinguished name such as dc=example,dc=com, got %q.", v.ValueString()))
+	}
+}
+
+// ldapFilterBalanced reports whether filter is one parenthesized expression.
+func ldapFilterBalanced(filter string) bool {
+	if !strings.HasPrefix(filter, "(") || !strings.HasSuffix(filter, ")") {
+		return false
+	}
+	depth := 0
+	for i, r := range filter {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth < 0 || (depth == 0 && i < len(filter)-1) {
+			return false
+		}
+	}
+	return depth == 0
+}
+
+func renderLDAPCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model ldapCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setInt64(doc, "port", model.Port)
+	setString(doc, "encryption", model.Encryption)
+	setString(doc, "bind_dn", model.BindDN)
+	setString(doc, "base_dn", model.BaseDN)
+	setString(doc, "filter", model.Filter)
+	setString(doc, "scope", model.Scope)
+	setInt64(doc, "min_entries", model.MinEntries)
+	setInt64(doc, "max_entries", model.MaxEntries)
+	return doc, diags
+}
+
+func ldapCheckConfigSecrets(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model ldapCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	secrets := map[string]interface{}{}
+	setString(secrets, "password", model.PasswordWO)
+	return secrets, diags
+}
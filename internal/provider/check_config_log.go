@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// maxLogWindow bounds how far back the agent keeps matches of a log check.
+const maxLogWindow = 24 * time.Hour
+
+var logCheckConfig = typedCheckConfig{
+	name:      "log_config",
+	checkType: "log",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for log checks run by the agent. Replaces config. The agent follows the file across rotations and fails the check once pattern matched threshold_count lines within window.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Description: "Absolute path of the log file, e.g. /var/log/nginx/error.log. Required.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringMatches(absolutePath, "an absolute path such as /var/log/syslog"),
+				},
+			},
+			"pattern": schema.StringAttribute{
+				Description: "RE2 expression matched against each new line, e.g. (?i)out of memory. Required.",
+				Optional:    true,
+				Validators: []validator.String{
+					regularExpression(),
+				},
+			},
+			"window": schema.StringAttribute{
+				Description: "Period in which matches are counted, e.g. 5m. At most 24h. Defaults to 5m on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					duration(),
+				},
+			},
+			"threshold_count": schema.Int64Attribute{
+				Description: "Number of matching lines within window at which the check fails. Defaults to 1 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 1000000),
+				},
+			},
+		},
+	},
+	required: []string{"path", "pattern"},
+	validate: validateLogCheckConfig,
+	render:   renderLogCheckConfig,
+}
+
+type logCheckConfigModel struct {
+	Path           types.String `tfsdk:"path"`
+	Pattern        types.String `tfsdk:"pattern"`
+	Window         types.String `tfsdk:"window"`
+	ThresholdCount types.Int64  `tfsdk:"threshold_count"`
+}
+
+func validateLogCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model logCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+
+	if model.Window.IsNull() || model.Window.IsUnknown() {
+		return diags
+	}
+	// Malformed durations are reported by the attribute validator.
+	if window, err := time.ParseDuration(model.Window.ValueString()); err == nil && window > maxLogWindow {
+		diags.AddAttributeError(path.Root("log_config").AtName("window"), "Invalid window",
+			fmt.Sprintf("window must be at most 24h, got %s.", model.Window.ValueString()))
+	}
+	return diags
+}
+
+func renderLogCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model logCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setString(doc, "path", model.Path)
+	setString(doc, "pattern", model.Pattern)
+	setString(doc, "window", model.Window)
+	setInt64(doc, "threshold_count", model.ThresholdCount)
+	return doc, diags
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// The mail protocol blocks share one schema; only the check type and the
+// server's default port differ.
+var (
+	smtpCheckConfig = mailCheckConfig("smtp", 25)
+	imapCheckConfig = mailCheckConfig("imap", 143)
+	pop3CheckConfig = mailCheckConfig("pop3", 110)
+)
+
+func mailCheckConfig(protocol string, defaultPort int64) typedCheckConfig {
+	name := protocol + "_config"
+	return typedCheckConfig{
+		name:      name,
+		checkType: protocol,
+		block: schema.SingleNestedBlock{
+			Description: fmt.Sprintf("Typed config for %s checks. Replaces config. The password is write-only and never stored in state.", protocol),
+			Attributes: map[string]schema.Attribute{
+				"port": schema.Int64Attribute{
+					Description: fmt.Sprintf("TCP port. Defaults to %d on the server.", defaultPort),
+					Optional:    true,
+					Validators: []validator.Int64{
+						int64Between(1, 65535),
+					},
+				},
+				"encryption": schema.StringAttribute{
+					Description: "Transport security (none, starttls, tls). starttls fails if the server does not offer STARTTLS. Defaults to none on the server.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringOneOf("none", "starttls", "tls"),
+					},
+				},
+				"expected_banner": schema.StringAttribute{
+					Description: "Text the server greeting must contain.",
+					Optional:    true,
+				},
+				"username": schema.StringAttribute{
+					Description: "Log in with this user after connecting. Without it, only the greeting is checked.",
+					Optional:    true,
+				},
+				"password_wo": schema.StringAttribute{
+					Description: "Login password. Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+					Optional:    true,
+					Sensitive:   true,
+					WriteOnly:   true,
+				},
+				"credentials_wo_version": schema.Int64Attribute{
+					Description: "Terraform cannot detect changes to write-only values. Change this number to send password_wo again.",
+					Optional:    true,
+				},
+			},
+		},
+		unrendered: []string{"password_wo", "credentials_wo_version"},
+		validate: func(ctx context.Context, obj types.Object) diag.Diagnostics {
+			return validateMailCheckConfig(ctx, path.Root(name), obj)
+		},
+		render:  renderMailCheckConfig,
+		secrets: mailCheckConfigSecrets,
+	}
+}
+
+type mailCheckConfigModel struct {
+	Port                 types.Int64  `tfsdk:"port"`
+	Encryption           types.String `tfsdk:"encryption"`
+	ExpectedBanner       types.String `tfsdk:"expected_banner"`
+	Username             types.String `tfsdk:"username"`
+	PasswordWO           types.String `tfsdk:"password_wo"`
+	CredentialsWOVersion types.Int64  `tfsdk:"credentials_wo_version"`
+}
+
+func validateMailCheckConfig(ctx context.Context, block path.Path, obj types.Object) diag.Diagnostics {
+	var model mailCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+
+	if !model.PasswordWO.IsNull() && model.Username.IsNull() {
+		diags.AddAttributeError(block.AtName("password_wo"), "Missing required argument",
+			"password_wo is the login password and needs username.")
+	}
+	if !model.Username.IsNull() && model.PasswordWO.IsNull() {
+		diags.AddAttributeError(block.AtName("password_wo"), "Missing required argument",
+			"username logs in after connecting and needs password_wo.")
+	}
+	return diags
+}
+
+func renderMailCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model mailCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setInt64(doc, "port", model.Port)
+	setString(doc, "encryption", model.Encryption)
+	setString(doc, "expected_banner", model.ExpectedBanner)
+	setString(doc, "username", model.Username)
+	return doc, diags
+}
+
+func mailCheckConfigSecrets(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model mailCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	secrets := map[string]interface{}{}
+	setString(secrets, "password", model.PasswordWO)
+	return secrets, diags
+}
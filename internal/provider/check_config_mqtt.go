@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var mqttCheckConfig = typedCheckConfig{
+	name:      "mqtt_config",
+	checkType: "mqtt",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for mqtt checks. Replaces config. Without topic only the connection is checked; with it the check subscribes, publishes a unique payload and waits for it to come back. The password is write-only and never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				Description: "TCP port. Defaults to 8883 with use_tls and 1883 without on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 65535),
+				},
+			},
+			"use_tls": schema.BoolAttribute{
+				Description: "Connect with TLS. Defaults to false on the server.",
+				Optional:    true,
+			},
+			"client_id": schema.StringAttribute{
+				Description: "Client identifier. Defaults to a random tinymon-* ID on the server.",
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "User name sent in CONNECT.",
+				Optional:    true,
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "Password sent in CONNECT. Needs username. Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				Description: "Terraform cannot detect changes to write-only values. Change this number to send password_wo again.",
+				Optional:    true,
+			},
+			"topic": schema.StringAttribute{
+				Description: "Topic for the publish/subscribe round trip, e.g. monitoring/tinymon. Must not contain wildcards. The client needs publish and subscribe rights on it.",
+				Optional:    true,
+			},
+			"qos": schema.Int64Attribute{
+				Description: "QoS level (0, 1, 2) of the round trip. Defaults to 1 on the server. Needs topic.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(0, 2),
+				},
+			},
+			"max_round_trip_ms": schema.Int64Attribute{
+				Description: "The check fails if the published message takes longer to arrive. Defaults to 5000 on the server. Needs topic.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 60000),
+				},
+			},
+		},
+	},
+	unrendered: []string{"password_wo", "credentials_wo_version"},
+	validate:   validateMQTTCheckConfig,
+	render:     renderMQTTCheckConfig,
+	secrets:    mqttCheckConfigSecrets,
+}
+
+type mqttCheckConfigModel struct {
+	Port                 types.Int64  `tfsdk:"port"`
+	UseTLS               types.Bool   `tfsdk:"use_tls"`
+	ClientID             types.String `tfsdk:"client_id"`
+	Username             types.String `tfsdk:"username"`
+	PasswordWO           types.String `tfsdk:"password_wo"`
+	CredentialsWOVersion types.Int64  `tfsdk:"credentials_wo_version"`
+	Topic                types.String `tfsdk:"topic"`
+	QoS                  types.Int64  `tfsdk:"qos"`
+	MaxRoundTripMS       types.Int64  `tfsdk:"max_round_trip_ms"`
+}
+
+func validateMQTTCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model mqttCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	block := path.Root("mqtt_config")
+
+	if !model.PasswordWO.IsNull() && model.Username.IsNull() {
+		diags.AddAttributeError(block.AtName("username"), "Missing required argument",
+			"MQTT sends a password only together with a user name; set username.")
+	}
+
+	if !model.Topic.IsNull() && !model.Topic.IsUnknown() {
+		topic := model.Topic.ValueString()
+		if topic == "" || strings.ContainsAny(topic, "+#") || strings.HasPrefix(topic, "$") {
+			diags.AddAttributeError(block.AtName("topic"), "Invalid topic",
+				"topic is published to and must be a non-empty topic name without the wildcards + and # and not start with $.")
+		}
+	}
+	if model.Topic.IsNull() {
+		if !model.QoS.IsNull() {
+			diags.AddAttributeError(block.AtName("qos"), "Missing required argument",
+				"qos applies to the round trip and needs topic.")
+		}
+		if !model.MaxRoundTripMS.IsNull() {
+			diags.AddAttributeError(block.AtName("max_round_trip_ms"), "Missing required argument",
+				"max_round_trip_ms applies to the round trip and needs topic.")
+		}
+	}
+	return diags
+}
+
+func renderMQTTCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model mqttCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setInt64(doc, "port", model.Port)
+	setBool(doc, "use_tls", model.UseTLS)
+	setString(doc, "client_id", model.ClientID)
+	setString(doc, "username", model.Username)
+	setString(doc, "topic", model.Topic)
+	setInt64(doc, "qos", model.QoS)
+	setInt64(doc, "max_round_trip_ms", model.MaxRoundTripMS)
+	return doc, diags
+}
+
+func mqttCheckConfigSecrets(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model mqttCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	secrets := map[string]interface{}{}
+	setString(secrets, "password", model.PasswordWO)
+	return secrets, diags
+}
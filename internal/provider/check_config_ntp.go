@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var ntpCheckConfig = typedCheckConfig{
+	name:      "ntp_config",
+	checkType: "ntp",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for ntp checks. Replaces config. The check queries an NTP server and compares its time with the monitoring server's clock.",
+		Attributes: map[string]schema.Attribute{
+			"server": schema.StringAttribute{
+				Description: "NTP server to query. Defaults to the host address.",
+				Optional:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "UDP port. Defaults to 123 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 65535),
+				},
+			},
+			"max_offset_ms": schema.Int64Attribute{
+				Description: "The check fails if the clock offset, in either direction, exceeds this many milliseconds. Defaults to 1000 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 3600000),
+				},
+			},
+			"max_stratum": schema.Int64Attribute{
+				Description: "The check fails if the server reports a higher stratum, i.e. is further from a reference clock. Stratum 16 (unsynchronized) always fails.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 15),
+				},
+			},
+		},
+	},
+	render: renderNTPCheckConfig,
+}
+
+type ntpCheckConfigModel struct {
+	Server      types.String `tfsdk:"server"`
+	Port        types.Int64  `tfsdk:"port"`
+	MaxOffsetMS types.Int64  `tfsdk:"max_offset_ms"`
+	MaxStratum  types.Int64  `tfsdk:"max_stratum"`
+}
+
+func renderNTPCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model ntpCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setString(doc, "server", model.Server)
+	setInt64(doc, "port", model.Port)
+	setInt64(doc, "max_offset_ms", model.MaxOffsetMS)
+	setInt64(doc, "max_stratum", model.MaxStratum)
+	return doc, diags
+}
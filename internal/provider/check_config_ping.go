@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var pingCheckConfig = typedCheckConfig{
+	name:      "ping_config",
+	checkType: "ping",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for ping (ICMP) checks. Replaces config.",
+		Attributes: map[string]schema.Attribute{
+			"packet_count": schema.Int64Attribute{
+				Description: "Number of echo requests per run.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 100),
+				},
+			},
+			"timeout_ms": schema.Int64Attribute{
+				Description: "Timeout per echo request in milliseconds.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 60000),
+				},
+			},
+			"max_rtt_ms": schema.Int64Attribute{
+				Description: "Average round-trip time above which the check fails. Must be below timeout_ms.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 60000),
+				},
+			},
+			"max_loss_percent": schema.Float64Attribute{
+				Description: "Packet loss above which the check fails.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64Between(0, 100),
+				},
+			},
+		},
+	},
+	validate: validatePingCheckConfig,
+	render:   renderPingCheckConfig,
+}
+
+type pingCheckConfigModel struct {
+	PacketCount    types.Int64   `tfsdk:"packet_count"`
+	TimeoutMS      types.Int64   `tfsdk:"timeout_ms"`
+	MaxRTTMS       types.Int64   `tfsdk:"max_rtt_ms"`
+	MaxLossPercent types.Float64 `tfsdk:"max_loss_percent"`
+}
+
+func validatePingCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model pingCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+
+	if model.MaxRTTMS.IsNull() || model.MaxRTTMS.IsUnknown() || model.TimeoutMS.IsNull() || model.TimeoutMS.IsUnknown() {
+		return diags
+	}
+	if model.MaxRTTMS.ValueInt64() >= model.TimeoutMS.ValueInt64() {
+		diags.AddAttributeError(path.Root("ping_config").AtName("max_rtt_ms"), "Invalid ping threshold",
+			fmt.Sprintf("max_rtt_ms (%d) must be lower than timeout_ms (%d).", model.MaxRTTMS.ValueInt64(), model.TimeoutMS.ValueInt64()))
+	}
+	return diags
+}
+
+func renderPingCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model pingCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setInt64(doc, "packet_count", model.PacketCount)
+	setInt64(doc, "timeout_ms", model.TimeoutMS)
+	setInt64(doc, "max_rtt_ms", model.MaxRTTMS)
+	setFloat64(doc, "max_loss_percent", model.MaxLossPercent)
+	return doc, diags
+}
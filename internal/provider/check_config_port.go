@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var portCheckConfig = typedCheckConfig{
+	name:      "port_config",
+	checkType: "port",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for port (TCP) checks. Replaces config.",
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				Description: "TCP port to connect to. Required.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 65535),
+				},
+			},
+			"send_string": schema.StringAttribute{
+				Description: "Data sent after connecting, e.g. \"PING\\r\\n\".",
+				Optional:    true,
+			},
+			"expect_string": schema.StringAttribute{
+				Description: "Text the response must contain.",
+				Optional:    true,
+			},
+			"use_tls": schema.BoolAttribute{
+				Description: "Wrap the connection in TLS.",
+				Optional:    true,
+			},
+		},
+	},
+	required: []string{"port"},
+	render:   renderPortCheckConfig,
+}
+
+type portCheckConfigModel struct {
+	Port         types.Int64  `tfsdk:"port"`
+	SendString   types.String `tfsdk:"send_string"`
+	ExpectString types.String `tfsdk:"expect_string"`
+	UseTLS       types.Bool   `tfsdk:"use_tls"`
+}
+
+func renderPortCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model portCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setInt64(doc, "port", model.Port)
+	setString(doc, "send_string", model.SendString)
+	setString(doc, "expect_string", model.ExpectString)
+	setBool(doc, "use_tls", model.UseTLS)
+	return doc, diags
+}
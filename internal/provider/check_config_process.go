@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var processCheckConfig = typedCheckConfig{
+	name:      "process_config",
+	checkType: "process",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for process checks run by the agent. Replaces config. The check counts the matching processes. Set exactly one of name and pattern.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Executable name the process must have, e.g. nginx.",
+				Optional:    true,
+			},
+			"pattern": schema.StringAttribute{
+				Description: "RE2 expression matched against the full command line, e.g. java .*-jar /opt/app\\.jar.",
+				Optional:    true,
+				Validators: []validator.String{
+					regularExpression(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Description: "Only count processes running as this user.",
+				Optional:    true,
+			},
+			"min_count": schema.Int64Attribute{
+				Description: "The check fails if fewer processes match. Defaults to 1 on the server. Set to 0 with max_count = 0 to alert on a process that must not run.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(0, 100000),
+				},
+			},
+			"max_count": schema.Int64Attribute{
+				Description: "The check fails if more processes match. If unset, there is no upper limit.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(0, 100000),
+				},
+			},
+		},
+	},
+	validate: validateProcessCheckConfig,
+	render:   renderProcessCheckConfig,
+}
+
+type processCheckConfigModel struct {
+	Name     types.String `tfsdk:"name"`
+	Pattern  types.String `tfsdk:"pattern"`
+	User     types.String `tfsdk:"user"`
+	MinCount types.Int64  `tfsdk:"min_count"`
+	MaxCount types.Int64  `tfsdk:"max_count"`
+}
+
+func validateProcessCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model processCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	block := path.Root("process_config")
+
+	if model.Name.IsNull() && model.Pattern.IsNull() {
+		diags.AddAttributeError(block.AtName("name"), "Missing required argument",
+			"Set name or pattern to select the processes.")
+	}
+	if !model.Name.IsNull() && !model.Pattern.IsNull() {
+		diags.AddAttributeError(block.AtName("pattern"), "Invalid attribute combination",
+			"name and pattern cannot both be set.")
+	}
+
+	minCount := int64(1)
+	if !model.MinCount.IsNull() {
+		minCount = model.MinCount.ValueInt64()
+	}
+	if !model.MinCount.IsUnknown() && !model.MaxCount.IsNull() && !model.MaxCount.IsUnknown() &&
+		model.MaxCount.ValueInt64() < minCount {
+		diags.AddAttributeError(block.AtName("max_count"), "Invalid process count",
+			fmt.Sprintf("max_count (%d) must not be less than min_count (%d).", model.MaxCount.ValueInt64(), minCount))
+	}
+	return diags
+}
+
+func renderProcessCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model processCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setString(doc, "name", model.Name)
+	setString(doc, "pattern", model.Pattern)
+	setString(doc, "user", model.User)
+	setInt64(doc, "min_count", model.MinCount)
+	setInt64(doc, "max_count", model.MaxCount)
+	return doc, diags
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// redisInfoField matches field names of the INFO reply, e.g. connected_clients
+// or db0.
+var redisInfoField = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+var redisCheckConfig = typedCheckConfig{
+	name:      "redis_config",
+	checkType: "redis",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for redis checks. Replaces config. The password is write-only and never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				Description: "TCP port. Defaults to 6379 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 65535),
+				},
+			},
+			"tls": schema.BoolAttribute{
+				Description: "Connect with TLS. Defaults to false on the server.",
+				Optional:    true,
+			},
+			"database": schema.Int64Attribute{
+				Description: "Database number to SELECT. Defaults to 0 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(0, 65535),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "ACL user (Redis 6+). Needs password_wo. Without it, password_wo authenticates the default user.",
+				Optional:    true,
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "Password for AUTH. Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				Description: "Terraform cannot detect changes to write-only values. Change this number to send password_wo again.",
+				Optional:    true,
+			},
+			"command": schema.StringAttribute{
+				Description: "Command to send (PING, INFO). Defaults to PING on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("PING", "INFO"),
+				},
+			},
+			"info_field": schema.StringAttribute{
+				Description: "Field of the INFO reply to compare, e.g. connected_clients or role. Needs command INFO.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringMatches(redisInfoField, "an INFO field name such as connected_clients"),
+				},
+			},
+			"expected_value": schema.StringAttribute{
+				Description: "Value the PING reply or info_field is compared with. If unset, any reply is accepted.",
+				Optional:    true,
+			},
+			"operator": schema.StringAttribute{
+				Description: "Comparison of the reply with expected_value (eq, ne, lt, le, gt, ge, contains, regex). Defaults to eq on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf(comparisonOperators...),
+				},
+			},
+			"max_latency_ms": schema.Int64Attribute{
+				Description: "The check fails if the command takes longer to answer.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 60000),
+				},
+			},
+		},
+	},
+	unrendered: []string{"password_wo", "credentials_wo_version"},
+	validate:   validateRedisCheckConfig,
+	render:     renderRedisCheckConfig,
+	secrets:    redisCheckConfigSecrets,
+}
+
+type redisCheckConfigModel struct {
+	Port                 types.Int64  `tfsdk:"port"`
+	TLS                  types.Bool   `tfsdk:"tls"`
+	Database             types.Int64  `tfsdk:"database"`
+	Username             types.String `tfsdk:"username"`
+	PasswordWO           types.String `tfsdk:"password_wo"`
+	CredentialsWOVersion types.Int64  `tfsdk:"credentials_wo_version"`
+	Command              types.String `tfsdk:"command"`
+	InfoField            types.String `tfsdk:"info_field"`
+	ExpectedValue        types.String `tfsdk:"expected_value"`
+	Operator             types.String `tfsdk:"operator"`
+	MaxLatencyMS         types.Int64  `tfsdk:"max_latency_ms"`
+}
+
+func validateRedisCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model redisCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	block := path.Root("redis_config")
+
+	if !model.Username.IsNull() && model.PasswordWO.IsNull() {
+		diags.AddAttributeError(block.AtName("password_wo"), "Missing required argument",
+			"username authenticates with AUTH and needs password_wo.")
+	}
+	if !model.Operator.IsNull() && model.ExpectedValue.IsNull() {
+		diags.AddAttributeError(block.AtName("operator"), "Missing expected_value",
+			"operator compares the reply with expected_value and needs it.")
+	}
+	validateComparison(&diags, block.AtName("expected_value"), model.Operator, model.ExpectedValue)
+
+	if model.Command.IsUnknown() {
+		return diags
+	}
+	if model.Command.ValueString() == "INFO" {
+		if !model.ExpectedValue.IsNull() && model.InfoField.IsNull() {
+			diags.AddAttributeError(block.AtName("info_field"), "Missing required argument",
+				"expected_value is compared with one field of the INFO reply; set info_field.")
+		}
+	} else if !model.InfoField.IsNull() {
+		diags.AddAttributeError(block.AtName("info_field"), "Invalid attribute combination",
+			"info_field can only be set when command is \"INFO\".")
+	}
+	return diags
+}
+
+func renderRedisCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model redisCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setInt64(doc, "port", model.Port)
+	setBool(doc, "tls", model.TLS)
+	setInt64(doc, "database", model.Database)
+	setString(doc, "username", model.Username)
+	setString(doc, "command", model.Command)
+	setString(doc, "info_field", model.InfoField)
+	setString(doc, "expected_value", model.ExpectedValue)
+	setString(doc, "operator", model.Operator)
+	setInt64(doc, "max_latency_ms", model.MaxLatencyMS)
+	return doc, diags
+}
+
+func redisCheckConfigSecrets(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model redisCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	secrets := map[string]interface{}{}
+	setString(secrets, "password", model.PasswordWO)
+	return secrets, diags
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// sha256Hex matches hex-encoded SHA-256 digests as returned by filesha256().
+var sha256Hex = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+var scriptCheckConfig = typedCheckConfig{
+	name:      "script_config",
+	checkType: "script",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for script checks run by the agent. Replaces config. Set exactly one of script and path. The agent verifies the script's SHA-256 before every run, so a script changed on the host fails the check instead of running.",
+		Attributes: map[string]schema.Attribute{
+			"interpreter": schema.StringAttribute{
+				Description: "Interpreter the script is passed to (sh, bash, python3, perl, powershell). Defaults to sh for script; a path is executed directly.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("sh", "bash", "python3", "perl", "powershell"),
+				},
+			},
+			"script": schema.StringAttribute{
+				Description: "Script body, e.g. from file(). Sent to the agent with its SHA-256.",
+				Optional:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Absolute path of a script or plugin already on the host, e.g. /usr/lib/nagios/plugins/check_raid.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringMatches(absolutePath, "an absolute path such as /usr/lib/nagios/plugins/check_raid"),
+				},
+			},
+			"path_sha256": schema.StringAttribute{
+				Description: "Expected SHA-256 of the file at path, e.g. from filesha256(). If unset, the file is not verified.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringMatches(sha256Hex, "a lowercase hex SHA-256 digest"),
+				},
+			},
+			"arguments": schema.ListAttribute{
+				Description: "Arguments passed to the script.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"output_format": schema.StringAttribute{
+				Description: "How the result is read: plain passes on an expected exit code and keeps the output as message; nagios maps exit codes 0-3 to ok, warning, critical and unknown and parses performance data. Defaults to plain on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("plain", "nagios"),
+				},
+			},
+			"expected_exit_codes": schema.SetAttribute{
+				Description: "Exit codes that count as success with output_format plain. Defaults to [0] on the server.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "The script is killed and the check fails after this long. Defaults to 30 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 300),
+				},
+			},
+		},
+	},
+	validate: validateScriptCheckConfig,
+	render:   renderScriptCheckConfig,
+}
+
+type scriptCheckConfigModel struct {
+	Interpreter       types.String `tfsdk:"interpreter"`
+	Script            types.String `tfsdk:"script"`
+	Path              types.String `tfsdk:"path"`
+	PathSHA256        types.String `tfsdk:"path_sha256"`
+	Arguments         types.List   `tfsdk:"arguments"`
+	OutputFormat      types.String `tfsdk:"output_format"`
+	ExpectedExitCodes types.Set    `tfsdk:"expected_exit_codes"`
+	TimeoutSeconds    types.Int64  `tfsdk:"timeout_seconds"`
+}
+
+func validateScriptCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model scriptCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	block := path.Root("script_config")
+
+	if model.Script.IsNull() && model.Path.IsNull() {
+		diags.AddAttributeError(block.AtName("script"), "Missing required argument",
+			"Set script or path.")
+	}
+	if !model.Script.IsNull() && !model.Path.IsNull() {
+		diags.AddAttributeError(block.AtName("path"), "Invalid attribute combination",
+			"script and path cannot both be set.")
+	}
+	if !model.PathSHA256.IsNull() && model.Path.IsNull() {
+		diags.AddAttributeError(block.AtName("path_sha256"), "Missing required argument",
+			"path_sha256 verifies the file at path and needs it; the checksum of script is computed automatically.")
+	}
+
+	if model.ExpectedExitCodes.IsNull() || model.ExpectedExitCodes.IsUnknown() {
+		return diags
+	}
+	if model.OutputFormat.ValueString() == "nagios" {
+		diags.AddAttributeError(block.AtName("expected_exit_codes"), "Invalid attribute combination",
+			"expected_exit_codes only applies to output_format \"plain\"; nagios maps exit codes to states itself.")
+	}
+	var codes []types.Int64
+	if d := model.ExpectedExitCodes.ElementsAs(ctx, &codes, false); d.HasError() {
+		diags.Append(d...)
+		return diags
+	}
+	if len(codes) == 0 {
+		diags.AddAttributeError(block.AtName("expected_exit_codes"), "Missing exit codes",
+			"expected_exit_codes must contain at least one exit code.")
+	}
+	for _, code := range codes {
+		if !code.IsNull() && !code.IsUnknown() && (code.ValueInt64() < 0 || code.ValueInt64() > 255) {
+			diags.AddAttributeError(block.AtName("expected_exit_codes"), "Invalid exit code",
+				fmt.Sprintf("Exit codes are between 0 and 255, got %d.", code.ValueInt64()))
+		}
+	}
+	return diags
+}
+
+func renderScriptCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model scriptCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setString(doc, "interpreter", model.Interpreter)
+	setString(doc, "script", model.Script)
+	if !model.Script.IsNull() {
+		sum := sha256.Sum256([]byte(model.Script.ValueString()))
+		doc["script_sha256"] = hex.EncodeToString(sum[:])
+	}
+	setString(doc, "path", model.Path)
+	setString(doc, "path_sha256", model.PathSHA256)
+	setStringList(ctx, doc, "arguments", model.Arguments, &diags)
+	setString(doc, "output_format", model.OutputFormat)
+	if !model.ExpectedExitCodes.IsNull() {
+		codes := []int64{}
+		diags.Append(model.ExpectedExitCodes.ElementsAs(ctx, &codes, false)...)
+		// Sets have no order; sorting keeps the rendered config stable.
+		slices.Sort(codes)
+		doc["expected_exit_codes"] = codes
+	}
+	setInt64(doc, "timeout_seconds", model.TimeoutSeconds)
+	return doc, diags
+}
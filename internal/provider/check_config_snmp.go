@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// snmpOID matches numeric OIDs such as 1.3.6.1.2.1.1.3.0, optionally with a
+// leading dot.
+var snmpOID = regexp.MustCompile(`^\.?[0-9]+(\.[0-9]+)+$`)
+
+var snmpCheckConfig = typedCheckConfig{
+	name:      "snmp_config",
+	checkType: "snmp",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for snmp checks. Replaces config. Community strings and SNMPv3 passwords are write-only and never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"oid": schema.StringAttribute{
+				Description: "Numeric OID to query, e.g. 1.3.6.1.2.1.1.3.0. Required.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringMatches(snmpOID, "a numeric OID such as 1.3.6.1.2.1.1.3.0"),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "SNMP version (1, 2c, 3). Defaults to 2c on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("1", "2c", "3"),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description: "UDP port. Defaults to 161 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 65535),
+				},
+			},
+			"expected_value": schema.StringAttribute{
+				Description: "Value the OID is compared with. If unset, any answer is accepted.",
+				Optional:    true,
+			},
+			"operator": schema.StringAttribute{
+				Description: "Comparison of the answer with expected_value (eq, ne, lt, le, gt, ge, contains, regex). Defaults to eq on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf(comparisonOperators...),
+				},
+			},
+			"community_wo": schema.StringAttribute{
+				Description: "Community string for SNMP v1 and v2c. Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"security_name": schema.StringAttribute{
+				Description: "SNMPv3 user name. Required for version 3.",
+				Optional:    true,
+			},
+			"security_level": schema.StringAttribute{
+				Description: "SNMPv3 security level (noAuthNoPriv, authNoPriv, authPriv). Defaults to authPriv on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("noAuthNoPriv", "authNoPriv", "authPriv"),
+				},
+			},
+			"auth_protocol": schema.StringAttribute{
+				Description: "SNMPv3 authentication protocol (MD5, SHA, SHA256, SHA512).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("MD5", "SHA", "SHA256", "SHA512"),
+				},
+			},
+			"auth_password_wo": schema.StringAttribute{
+				Description: "SNMPv3 authentication password. Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"privacy_protocol": schema.StringAttribute{
+				Description: "SNMPv3 privacy protocol (DES, AES, AES256).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("DES", "AES", "AES256"),
+				},
+			},
+			"privacy_password_wo": schema.StringAttribute{
+				Description: "SNMPv3 privacy password. Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				Description: "Terraform cannot detect changes to write-only values. Change this number to send community_wo, auth_password_wo and privacy_password_wo again.",
+				Optional:    true,
+			},
+		},
+	},
+	required:   []string{"oid"},
+	unrendered: []string{"community_wo", "auth_password_wo", "privacy_password_wo", "credentials_wo_version"},
+	validate:   validateSNMPCheckConfig,
+	render:     renderSNMPCheckConfig,
+	secrets:    snmpCheckConfigSecrets,
+}
+
+type snmpCheckConfigModel struct {
+	OID                  types.String `tfsdk:"oid"`
+	Version              types.String `tfsdk:"version"`
+	Port                 types.Int64  `tfsdk:"port"`
+	ExpectedValue        types.String `tfsdk:"expected_value"`
+	Operator             types.String `tfsdk:"operator"`
+	CommunityWO          types.String `tfsdk:"community_wo"`
+	SecurityName         types.String `tfsdk:"security_name"`
+	SecurityLevel        types.String `tfsdk:"security_level"`
+	AuthProtocol         types.String `tfsdk:"auth_protocol"`
+	AuthPasswordWO       types.String `tfsdk:"auth_password_wo"`
+	PrivacyProtocol      types.String `tfsdk:"privacy_protocol"`
+	PrivacyPasswordWO    types.String `tfsdk:"privacy_password_wo"`
+	CredentialsWOVersion types.Int64  `tfsdk:"credentials_wo_version"`
+}
+
+func validateSNMPCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model snmpCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	block := path.Root("snmp_config")
+
+	if !model.Operator.IsNull() && model.ExpectedValue.IsNull() {
+		diags.AddAttributeError(block.AtName("operator"), "Missing expected_value",
+			"operator compares the answer with expected_value and needs it.")
+	}
+	validateComparison(&diags, block.AtName("expected_value"), model.Operator, model.ExpectedValue)
+
+	if model.Version.IsUnknown() {
+		return diags
+	}
+	if model.Version.ValueString() != "3" {
+		v3Attributes := []struct {
+			name  string
+			value attr.Value
+		}{
+			{"security_name", model.SecurityName},
+			{"security_level", model.SecurityLevel},
+			{"auth_protocol", model.AuthProtocol},
+			{"auth_password_wo", model.AuthPasswordWO},
+			{"privacy_protocol", model.PrivacyProtocol},
+			{"privacy_password_wo", model.PrivacyPasswordWO},
+		}
+		for _, a := range v3Attributes {
+			if !a.value.IsNull() {
+				diags.AddAttributeError(block.AtName(a.name), "Invalid attribute combination",
+					fmt.Sprintf("%s can only be set when version is \"3\".", a.name))
+			}
+		}
+		return diags
+	}
+
+	if !model.CommunityWO.IsNull() {
+		diags.AddAttributeError(block.AtName("community_wo"), "Invalid attribute combination",
+			"community_wo is not used by SNMPv3; set security_name and the v3 credentials instead.")
+	}
+	if model.SecurityName.IsNull() {
+		diags.AddAttributeError(block.AtName("security_name"), "Missing required argument",
+			"security_name is required when version is \"3\".")
+	}
+	if model.SecurityLevel.IsUnknown() {
+		return diags
+	}
+	level := model.SecurityLevel.ValueString()
+	if model.SecurityLevel.IsNull() {
+		level = "authPriv"
+	}
+	if level != "noAuthNoPriv" {
+		requireSNMPAttribute(&diags, block, "auth_protocol", model.AuthProtocol, level)
+		requireSNMPAttribute(&diags, block, "auth_password_wo", model.AuthPasswordWO, level)
+	}
+	if level == "authPriv" {
+		requireSNMPAttribute(&diags, block, "privacy_protocol", model.PrivacyProtocol, level)
+		requireSNMPAttribute(&diags, block, "privacy_password_wo", model.PrivacyPasswordWO, level)
+	}
+	return diags
+}
+
+func requireSNMPAttribute(diags *diag.Diagnostics, block path.Path, name string, value attr.Value, level string) {
+	if value.IsNull() {
+		diags.AddAttributeError(block.AtName(name), "Missing required argument",
+			fmt.Sprintf("%s is required for security_level %q.", name, level))
+	}
+}
+
+func renderSNMPCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model snmpCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setString(doc, "oid", model.OID)
+	setString(doc, "version", model.Version)
+	setInt64(doc, "port", model.Port)
+	setString(doc, "expected_value", model.ExpectedValue)
+	setString(doc, "operator", model.Operator)
+	setString(doc, "security_name", model.SecurityName)
+	setString(doc, "security_level", model.SecurityLevel)
+	setString(doc, "auth_protocol", model.AuthProtocol)
+	setString(doc, "privacy_protocol", model.PrivacyProtocol)
+	return doc, diags
+}
+
+func snmpCheckConfigSecrets(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model snmpCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	secrets := map[string]interface{}{}
+	setString(secrets, "community", model.CommunityWO)
+	setString(secrets, "auth_password", model.AuthPasswordWO)
+	setString(secrets, "privacy_password", model.PrivacyPasswordWO)
+	return secrets, diags
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// sshHostKeyFingerprint matches OpenSSH SHA256 fingerprints as printed by
+// ssh-keygen -lf, e.g. SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8.
+var sshHostKeyFingerprint = regexp.MustCompile(`^SHA256:[A-Za-z0-9+/]{43}$`)
+
+var sshCheckConfig = typedCheckConfig{
+	name:      "ssh_config",
+	checkType: "ssh",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for ssh checks. Replaces config. Without username only the banner is checked; with it the check logs in with a key and can run a command. The private key is write-only and never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				Description: "TCP port. Defaults to 22 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 65535),
+				},
+			},
+			"expected_banner": schema.StringAttribute{
+				Description: "Text the server's identification string must contain, e.g. OpenSSH_9.",
+				Optional:    true,
+			},
+			"host_key_fingerprint": schema.StringAttribute{
+				Description: "Expected SHA256 fingerprint of the host key, as printed by ssh-keygen -lf. If unset, any host key is accepted.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringMatches(sshHostKeyFingerprint, "a SHA256 fingerprint such as SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8"),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "Log in as this user after the handshake. Needs private_key_wo.",
+				Optional:    true,
+			},
+			"private_key_wo": schema.StringAttribute{
+				Description: "PEM-encoded private key for username, e.g. from file(). Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"private_key_passphrase_wo": schema.StringAttribute{
+				Description: "Passphrase of an encrypted private key. Write-only: sent to the server but never stored in state (Terraform 1.11+).",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				Description: "Terraform cannot detect changes to write-only values. Change this number to send private_key_wo and private_key_passphrase_wo again.",
+				Optional:    true,
+			},
+			"command": schema.StringAttribute{
+				Description: "Command to run after logging in. The check fails if it exits non-zero. Needs username.",
+				Optional:    true,
+			},
+			"expected_output": schema.StringAttribute{
+				Description: "Value the command's output, without trailing newlines, is compared with. Needs command.",
+				Optional:    true,
+			},
+			"operator": schema.StringAttribute{
+				Description: "Comparison of the output with expected_output (eq, ne, lt, le, gt, ge, contains, regex). Defaults to eq on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf(comparisonOperators...),
+				},
+			},
+		},
+	},
+	unrendered: []string{"private_key_wo", "private_key_passphrase_wo", "credentials_wo_version"},
+	validate:   validateSSHCheckConfig,
+	render:     renderSSHCheckConfig,
+	secrets:    sshCheckConfigSecrets,
+}
+
+type sshCheckConfigModel struct {
+	Port                   types.Int64  `tfsdk:"port"`
+	ExpectedBanner         types.String `tfsdk:"expected_banner"`
+	HostKeyFingerprint     types.String `tfsdk:"host_key_fingerprint"`
+	Username               types.String `tfsdk:"username"`
+	PrivateKeyWO           types.String `tfsdk:"private_key_wo"`
+	PrivateKeyPassphraseWO types.String `tfsdk:"private_key_passphrase_wo"`
+	CredentialsWOVersion   types.Int64  `tfsdk:"credentials_wo_version"`
+	Command                types.String `tfsdk:"command"`
+	ExpectedOutput         types.String `tfsdk:"expected_output"`
+	Operator               types.String `tfsdk:"operator"`
+}
+
+func validateSSHCheckConfig(ctx context.Context, obj types.Object) diag.Diagnostics {
+	var model sshCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	block := path.Root("ssh_config")
+
+	if !model.Username.IsNull() && model.PrivateKeyWO.IsNull() {
+		diags.AddAttributeError(block.AtName("private_key_wo"), "Missing required argument",
+			"username logs in with a key and needs private_key_wo.")
+	}
+	if !model.PrivateKeyWO.IsNull() && model.Username.IsNull() {
+		diags.AddAttributeError(block.AtName("username"), "Missing required argument",
+			"private_key_wo is used to log in and needs username.")
+	}
+	if !model.PrivateKeyPassphraseWO.IsNull() && model.PrivateKeyWO.IsNull() {
+		diags.AddAttributeError(block.AtName("private_key_passphrase_wo"), "Missing required argument",
+			"private_key_passphrase_wo decrypts private_key_wo and needs it.")
+	}
+	if !model.PrivateKeyWO.IsNull() && !model.PrivateKeyWO.IsUnknown() &&
+		!strings.Contains(model.PrivateKeyWO.ValueString(), "PRIVATE KEY-----") {
+		diags.AddAttributeError(block.AtName("private_key_wo"), "Invalid private key",
+			"private_key_wo must be a PEM-encoded private key, e.g. file(\"~/.ssh/id_ed25519\").")
+	}
+
+	if !model.Command.IsNull() && model.Username.IsNull() {
+		diags.AddAttributeError(block.AtName("command"), "Missing required argument",
+			"command runs after logging in and needs username.")
+	}
+	if !model.ExpectedOutput.IsNull() && model.Command.IsNull() {
+		diags.AddAttributeError(block.AtName("expected_output"), "Missing required argument",
+			"expected_output is compared with the output of command and needs it.")
+	}
+	if !model.Operator.IsNull() && model.ExpectedOutput.IsNull() {
+		diags.AddAttributeError(block.AtName("operator"), "Missing expected_output",
+			"operator compares the output with expected_output and needs it.")
+	}
+	validateComparison(&diags, block.AtName("expected_output"), model.Operator, model.ExpectedOutput)
+	return diags
+}
+
+func renderSSHCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model sshCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setInt64(doc, "port", model.Port)
+	setString(doc, "expected_banner", model.ExpectedBanner)
+	setString(doc, "host_key_fingerprint", model.HostKeyFingerprint)
+	setString(doc, "username", model.Username)
+	setString(doc, "command", model.Command)
+	setString(doc, "expected_output", model.ExpectedOutput)
+	setString(doc, "operator", model.Operator)
+	return doc, diags
+}
+
+func sshCheckConfigSecrets(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model sshCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	secrets := map[string]interface{}{}
+	setString(secrets, "private_key", model.PrivateKeyWO)
+	setString(secrets, "private_key_passphrase", model.PrivateKeyPassphraseWO)
+	return secrets, diags
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// The disk, load and memory blocks configure metrics collected by the
+// TinyMon agent, so the host needs a tinymon_agent allowed to run the type.
+
+// absolutePath matches absolute Unix paths and Windows paths starting with a
+// drive such as C:\.
+var absolutePath = regexp.MustCompile(`^(/.*|[A-Za-z]:\\.*)$`)
+
+var diskCheckConfig = typedCheckConfig{
+	name:      "disk_config",
+	checkType: "disk",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for disk checks run by the agent. Replaces config.",
+		Attributes: map[string]schema.Attribute{
+			"mount": schema.StringAttribute{
+				Description: "Mount point whose usage is checked, e.g. / or C:\\. Required.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringMatches(absolutePath, "an absolute mount point such as / or C:\\"),
+				},
+			},
+			"warning_percent": schema.Int64Attribute{
+				Description: "Used space in percent above which the check warns. Defaults to 80 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 100),
+				},
+			},
+			"critical_percent": schema.Int64Attribute{
+				Description: "Used space in percent above which the check is critical. Defaults to 90 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 100),
+				},
+			},
+		},
+	},
+	required: []string{"mount"},
+	validate: func(ctx context.Context, obj types.Object) diag.Diagnostics {
+		return validateSystemLimits(obj, path.Root("disk_config"), [][2]string{{"warning_percent", "critical_percent"}})
+	},
+	render: renderDiskCheckConfig,
+}
+
+type diskCheckConfigModel struct {
+	Mount           types.String `tfsdk:"mount"`
+	WarningPercent  types.Int64  `tfsdk:"warning_percent"`
+	CriticalPercent types.Int64  `tfsdk:"critical_percent"`
+}
+
+func renderDiskCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model diskCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setString(doc, "mount", model.Mount)
+	setInt64(doc, "warning_percent", model.WarningPercent)
+	setInt64(doc, "critical_percent", model.CriticalPercent)
+	return doc, diags
+}
+
+var loadCheckConfig = typedCheckConfig{
+	name:      "load_config",
+	checkType: "load",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for load (CPU) checks run by the agent. Replaces config. Unset limits are not evaluated.",
+		Attributes: map[string]schema.Attribute{
+			"per_cpu": schema.BoolAttribute{
+				Description: "Divide the load averages by the number of CPUs before comparing, so one set of limits fits hosts of any size. Defaults to false on the server.",
+				Optional:    true,
+			},
+			"warning_load1":   loadLimitAttribute("warns", "1"),
+			"warning_load5":   loadLimitAttribute("warns", "5"),
+			"warning_load15":  loadLimitAttribute("warns", "15"),
+			"critical_load1":  loadLimitAttribute("is critical", "1"),
+			"critical_load5":  loadLimitAttribute("is critical", "5"),
+			"critical_load15": loadLimitAttribute("is critical", "15"),
+		},
+	},
+	validate: func(ctx context.Context, obj types.Object) diag.Diagnostics {
+		return validateSystemLimits(obj, path.Root("load_config"), [][2]string{
+			{"warning_load1", "critical_load1"},
+			{"warning_load5", "critical_load5"},
+			{"warning_load15", "critical_load15"},
+		})
+	},
+	render: renderLoadCheckConfig,
+}
+
+type loadCheckConfigModel struct {
+	PerCPU         types.Bool    `tfsdk:"per_cpu"`
+	WarningLoad1   types.Float64 `tfsdk:"warning_load1"`
+	WarningLoad5   types.Float64 `tfsdk:"warning_load5"`
+	WarningLoad15  types.Float64 `tfsdk:"warning_load15"`
+	CriticalLoad1  types.Float64 `tfsdk:"critical_load1"`
+	CriticalLoad5  types.Float64 `tfsdk:"critical_load5"`
+	CriticalLoad15 types.Float64 `tfsdk:"critical_load15"`
+}
+
+func renderLoadCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model loadCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setBool(doc, "per_cpu", model.PerCPU)
+	setFloat64(doc, "warning_load1", model.WarningLoad1)
+	setFloat64(doc, "warning_load5", model.WarningLoad5)
+	setFloat64(doc, "warning_load15", model.WarningLoad15)
+	setFloat64(doc, "critical_load1", model.CriticalLoad1)
+	setFloat64(doc, "critical_load5", model.CriticalLoad5)
+	setFloat64(doc, "critical_load15", model.CriticalLoad15)
+	return doc, diags
+}
+
+func loadLimitAttribute(level, minutes string) schema.Float64Attribute {
+	return schema.Float64Attribute{
+		Description: fmt.Sprintf("%s-minute load average above which the check %s.", minutes, level),
+		Optional:    true,
+		Validators: []validator.Float64{
+			float64Between(0, 10000),
+		},
+	}
+}
+
+var memoryCheckConfig = typedCheckConfig{
+	name:      "memory_config",
+	checkType: "memory",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for memory checks run by the agent. Replaces config. Usage excludes page cache and buffers, which the kernel frees on demand.",
+		Attributes: map[string]schema.Attribute{
+			"warning_percent": schema.Int64Attribute{
+				Description: "Used memory in percent above which the check warns. Defaults to 85 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 100),
+				},
+			},
+			"critical_percent": schema.Int64Attribute{
+				Description: "Used memory in percent above which the check is critical. Defaults to 95 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 100),
+				},
+			},
+			"swap_warning_percent": schema.Int64Attribute{
+				Description: "Used swap in percent above which the check warns. If unset, swap is not evaluated.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 100),
+				},
+			},
+			"swap_critical_percent": schema.Int64Attribute{
+				Description: "Used swap in percent above which the check is critical. If unset, swap is not evaluated.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 100),
+				},
+			},
+		},
+	},
+	validate: func(ctx context.Context, obj types.Object) diag.Diagnostics {
+		return validateSystemLimits(obj, path.Root("memory_config"), [][2]string{
+			{"warning_percent", "critical_percent"},
+			{"swap_warning_percent", "swap_critical_percent"},
+		})
+	},
+	render: renderMemoryCheckConfig,
+}
+
+type memoryCheckConfigModel struct {
+	WarningPercent      types.Int64 `tfsdk:"warning_percent"`
+	CriticalPercent     types.Int64 `tfsdk:"critical_percent"`
+	SwapWarningPercent  types.Int64 `tfsdk:"swap_warning_percent"`
+	SwapCriticalPercent types.Int64 `tfsdk:"swap_critical_percent"`
+}
+
+func renderMemoryCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model memoryCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setInt64(doc, "warning_percent", model.WarningPercent)
+	setInt64(doc, "critical_percent", model.CriticalPercent)
+	setInt64(doc, "swap_warning_percent", model.SwapWarningPercent)
+	setInt64(doc, "swap_critical_percent", model.SwapCriticalPercent)
+	return doc, diags
+}
+
+// validateSystemLimits checks that each warning limit is below its critical
+// limit when both are set. pairs holds attribute names as {warning, critical}.
+func validateSystemLimits(obj types.Object, block path.Path, pairs [][2]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	attrs := obj.Attributes()
+	for _, pair := range pairs {
+		w, wok := thresholdNumber(attrs[pair[0]])
+		c, cok := thresholdNumber(attrs[pair[1]])
+		if wok && cok && w >= c {
+			diags.AddAttributeError(block.AtName(pair[0]), "Invalid threshold",
+				fmt.Sprintf("%s must be lower than %s.", pair[0], pair[1]))
+		}
+	}
+	return diags
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// websocketPath matches absolute request paths, optionally with a query
+// string, e.g. /live or /socket?v=2.
+var websocketPath = regexp.MustCompile(`^/[^\s#]*$`)
+
+var websocketCheckConfig = typedCheckConfig{
+	name:      "websocket_config",
+	checkType: "websocket",
+	block: schema.SingleNestedBlock{
+		Description: "Typed config for websocket checks. Replaces config. The check opens a WebSocket to the host, optionally sends a message and waits for the first message from the server.",
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				Description: "TCP port. Defaults to 443 with use_tls and 80 without on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 65535),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Request path, e.g. /live. Defaults to / on the server.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringMatches(websocketPath, "an absolute path such as /live"),
+				},
+			},
+			"use_tls": schema.BoolAttribute{
+				Description: "Connect with wss:// instead of ws://. Defaults to false on the server.",
+				Optional:    true,
+			},
+			"subprotocol": schema.StringAttribute{
+				Description: "Subprotocol requested via Sec-WebSocket-Protocol, e.g. graphql-transport-ws. The check fails if the server does not accept it.",
+				Optional:    true,
+			},
+			"send_message": schema.StringAttribute{
+				Description: "Text message sent after the handshake.",
+				Optional:    true,
+			},
+			"expected_response": schema.StringAttribute{
+				Description: "Text the first message from the server must contain. If unset, a completed handshake passes.",
+				Optional:    true,
+			},
+			"handshake_timeout_ms": schema.Int64Attribute{
+				Description: "Timeout of the opening handshake. Defaults to 5000 on the server.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 60000),
+				},
+			},
+		},
+	},
+	render: renderWebSocketCheckConfig,
+}
+
+type websocketCheckConfigModel struct {
+	Port               types.Int64  `tfsdk:"port"`
+	Path               types.String `tfsdk:"path"`
+	UseTLS             types.Bool   `tfsdk:"use_tls"`
+	Subprotocol        types.String `tfsdk:"subprotocol"`
+	SendMessage        types.String `tfsdk:"send_message"`
+	ExpectedResponse   types.String `tfsdk:"expected_response"`
+	HandshakeTimeoutMS types.Int64  `tfsdk:"handshake_timeout_ms"`
+}
+
+func renderWebSocketCheckConfig(ctx context.Context, obj types.Object) (map[string]interface{}, diag.Diagnostics) {
+	var model websocketCheckConfigModel
+	diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	doc := map[string]interface{}{}
+	setInt64(doc, "port", model.Port)
+	setString(doc, "path", model.Path)
+	setBool(doc, "use_tls", model.UseTLS)
+	setString(doc, "subprotocol", model.Subprotocol)
+	setString(doc, "send_message", model.SendMessage)
+	setString(doc, "expected_response", model.ExpectedResponse)
+	setInt64(doc, "handshake_timeout_ms", model.HandshakeTimeoutMS)
+	return doc, diags
+}
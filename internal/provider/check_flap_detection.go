@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+var flapDetectionAttrTypes = map[string]attr.Type{
+	"enabled":   types.BoolType,
+	"window":    types.Int64Type,
+	"threshold": types.Float64Type,
+}
+
+// flapDetectionBlock is the flap_detection block of checks. Attributes are
+// Optional because the framework enforces Required attributes of a single
+// nested block even when it is absent.
+func flapDetectionBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "Hold notifications while the check is flapping, i.e. changes state too often. Without the block flap detection is off.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether flap detection is active. Defaults to true; set to false to keep the settings but pause detection.",
+				Optional:    true,
+			},
+			"window": schema.Int64Attribute{
+				Description: "Number of most recent results examined (2-100). Required.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(2, 100),
+				},
+			},
+			"threshold": schema.Float64Attribute{
+				Description: "Share of state changes within the window, in percent, above which the check counts as flapping (1-100). Required.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64Between(1, 100),
+				},
+			},
+		},
+	}
+}
+
+func validateFlapDetection(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var obj types.Object
+	diags.Append(config.GetAttribute(ctx, path.Root("flap_detection"), &obj)...)
+	if diags.HasError() || obj.IsNull() || obj.IsUnknown() {
+		return
+	}
+	for _, name := range []string{"window", "threshold"} {
+		if value, ok := obj.Attributes()[name]; ok && value.IsNull() {
+			diags.AddAttributeError(path.Root("flap_detection").AtName(name),
+				"Missing required argument", "The argument \""+name+"\" is required in flap_detection.")
+		}
+	}
+}
+
+// flapDetectionToAPI returns nil for an absent block, which turns flap
+// detection off.
+func flapDetectionToAPI(obj types.Object) *tinymon.FlapDetection {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	attrs := obj.Attributes()
+	return &tinymon.FlapDetection{
+		Enabled:   attrs["enabled"].(types.Bool).ValueBoolPointer(),
+		Window:    attrs["window"].(types.Int64).ValueInt64(),
+		Threshold: attrs["threshold"].(types.Float64).ValueFloat64(),
+	}
+}
+
+// flapDetectionToState keeps enabled null when it was not configured and the
+// server reports the default.
+func flapDetectionToState(settings *tinymon.FlapDetection, current types.Object) types.Object {
+	if settings == nil {
+		return types.ObjectNull(flapDetectionAttrTypes)
+	}
+	enabled := types.BoolPointerValue(settings.Enabled)
+	if settings.Enabled == nil || *settings.Enabled {
+		if current.IsNull() || current.IsUnknown() || current.Attributes()["enabled"].IsNull() {
+			enabled = types.BoolNull()
+		} else {
+			enabled = types.BoolValue(true)
+		}
+	}
+	return types.ObjectValueMust(flapDetectionAttrTypes, map[string]attr.Value{
+		"enabled":   enabled,
+		"window":    types.Int64Value(settings.Window),
+		"threshold": types.Float64Value(settings.Threshold),
+	})
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+var (
+	_ list.ListResource              = &checkListResource{}
+	_ list.ListResourceWithConfigure = &checkListResource{}
+)
+
+func NewCheckListResource() list.ListResource {
+	return &checkListResource{}
+}
+
+// checkListResource enumerates checks for terraform query and config
+// generation. Results carry the tinymon_check identity.
+type checkListResource struct {
+	client *TinyMonClient
+}
+
+type checkListResourceModel struct {
+	HostAddress types.String `tfsdk:"host_address"`
+	Topic       types.String `tfsdk:"topic"`
+	Tags        types.Map    `tfsdk:"tags"`
+}
+
+func (r *checkListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check"
+}
+
+func (r *checkListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists TinyMon checks. Without filters all checks are listed.",
+		Attributes: map[string]schema.Attribute{
+			"host_address": schema.StringAttribute{
+				Description: "Only list checks of the host with this address.",
+				Optional:    true,
+			},
+			"topic": schema.StringAttribute{
+				Description: "Only list checks of hosts in this topic.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Only list checks of hosts carrying all of these tags.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *checkListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *checkListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config checkListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tags := map[string]string{}
+	if !config.Tags.IsNull() {
+		diags.Append(config.Tags.ElementsAs(ctx, &tags, false)...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	checks, err := r.client.ListChecks(ctx, tinymon.ListChecksOptions{
+		HostAddress: config.HostAddress.ValueString(),
+		Topic:       config.Topic.ValueString(),
+		Tags:        tags,
+	})
+	if err != nil {
+		addAPIErrorDiagnostics(&diags, "Error listing checks", err)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i := range checks {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			check := &checks[i]
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = fmt.Sprintf("%s %s", check.HostAddress, check.Type)
+			if check.Name != "" {
+				result.DisplayName = fmt.Sprintf("%s (%s %s)", check.Name, check.HostAddress, check.Type)
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, checkResourceIdentityModel{
+				HostAddress: types.StringValue(check.HostAddress),
+				Type:        types.StringValue(check.Type),
+				Config:      types.StringValue(check.Config),
+			})...)
+			if req.IncludeResource {
+				// The typed config blocks stay null; the listed check is
+				// described by its raw config.
+				var state checkResourceModel
+				mapCheckResponseToState(check, &state)
+				for name, value := range map[string]interface{}{
+					"id":                     state.ID,
+					"host_address":           state.HostAddress,
+					"type":                   state.Type,
+					"name":                   state.Name,
+					"config":                 state.Config,
+					"interval_seconds":       state.IntervalSeconds,
+					"enabled":                state.Enabled,
+					"depends_on_check_id":    state.DependsOnCheckID,
+					"template_id":            state.TemplateID,
+					"failures_before_alert":  state.FailuresBeforeAlert,
+					"retry_interval_seconds": state.RetryIntervalSeconds,
+					"locations":              state.Locations,
+					"tag_ids":                state.TagIDs,
+					"warning_threshold":      state.WarningThreshold,
+					"critical_threshold":     state.CriticalThreshold,
+					"flap_detection":         state.FlapDetection,
+				} {
+					result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root(name), value)...)
+				}
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
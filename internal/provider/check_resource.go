@@ -3,11 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/url"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -15,14 +18,64 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
 )
 
 var (
-	_ resource.Resource                = &checkResource{}
-	_ resource.ResourceWithImportState = &checkResource{}
+	_ resource.Resource                   = &checkResource{}
+	_ resource.ResourceWithImportState    = &checkResource{}
+	_ resource.ResourceWithIdentity       = &checkResource{}
+	_ resource.ResourceWithModifyPlan     = &checkResource{}
+	_ resource.ResourceWithValidateConfig = &checkResource{}
+)
+
+// checkAPIFields maps check request fields to attributes for server
+// validation errors.
+var checkAPIFields = apiFieldPaths(
+	"host_address", "type", "name", "config", "interval_seconds", "enabled",
+	"depends_on_check_id", "template_id", "locations", "tag_ids",
+	"failures_before_alert", "retry_interval_seconds",
+	"warning_threshold", "critical_threshold", "flap_detection",
 )
 
+// knownCheckTypes are the check types this provider knows about. The server
+// may support fewer; that is verified against /api/push/check_types at plan time.
+var knownCheckTypes = []string{
+	"ping",
+	"http",
+	"port",
+	"certificate",
+	"content",
+	"content_hash",
+	"disk",
+	"disk_health",
+	"load",
+	"memory",
+	"dns",
+	"snmp",
+	"json_api",
+	"smtp",
+	"imap",
+	"pop3",
+	"ssh",
+	"database",
+	"redis",
+	"ntp",
+	"domain_expiry",
+	"blacklist",
+	"grpc",
+	"websocket",
+	"mqtt",
+	"ldap",
+	"process",
+	"log",
+	"script",
+}
+
 func NewCheckResource() resource.Resource {
 	return &checkResource{}
 }
@@ -32,35 +85,62 @@ type checkResource struct {
 }
 
 type checkResourceModel struct {
-	ID              types.Int64  `tfsdk:"id"`
-	HostAddress     types.String `tfsdk:"host_address"`
-	Type            types.String `tfsdk:"type"`
-	Config          types.String `tfsdk:"config"`
-	IntervalSeconds types.Int64  `tfsdk:"interval_seconds"`
-	Enabled         types.Bool   `tfsdk:"enabled"`
-}
-
-type checkAPIRequest struct {
-	HostAddress     string `json:"host_address"`
-	Type            string `json:"type"`
-	Config          string `json:"config"`
-	IntervalSeconds int64  `json:"interval_seconds"`
-	Enabled         int    `json:"enabled"`
-}
-
-type checkAPIResponse struct {
-	ID              int64  `json:"id"`
-	HostID          int64  `json:"host_id"`
-	Type            string `json:"type"`
-	Config          string `json:"config"`
-	IntervalSeconds int64  `json:"interval_seconds"`
-	Enabled         int    `json:"enabled"`
-}
-
-type checkDeleteRequest struct {
-	HostAddress string `json:"host_address"`
-	Type        string `json:"type"`
-	Config      string `json:"config"`
+	ID               types.Int64  `tfsdk:"id"`
+	HostAddress      types.String `tfsdk:"host_address"`
+	Type             types.String `tfsdk:"type"`
+	Name             types.String `tfsdk:"name"`
+	Config           types.String `tfsdk:"config"`
+	IntervalSeconds  types.Int64  `tfsdk:"interval_seconds"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	DependsOnCheckID types.Int64  `tfsdk:"depends_on_check_id"`
+	TemplateID       types.Int64  `tfsdk:"template_id"`
+	Locations        types.List   `tfsdk:"locations"`
+	TagIDs           types.Set    `tfsdk:"tag_ids"`
+	OnConflict       types.String `tfsdk:"on_conflict"`
+	Organization     types.String `tfsdk:"organization"`
+
+	FailuresBeforeAlert  types.Int64 `tfsdk:"failures_before_alert"`
+	RetryIntervalSeconds types.Int64 `tfsdk:"retry_interval_seconds"`
+
+	WaitForFirstResult        types.Bool  `tfsdk:"wait_for_first_result"`
+	FirstResultTimeoutSeconds types.Int64 `tfsdk:"first_result_timeout_seconds"`
+
+	LastStatus    types.String `tfsdk:"last_status"`
+	LastRunAt     types.String `tfsdk:"last_run_at"`
+	LastLatencyMS types.Int64  `tfsdk:"last_latency_ms"`
+
+	WarningThreshold  types.Object `tfsdk:"warning_threshold"`
+	CriticalThreshold types.Object `tfsdk:"critical_threshold"`
+	FlapDetection     types.Object `tfsdk:"flap_detection"`
+
+	DNSConfig          types.Object `tfsdk:"dns_config"`
+	PortConfig         types.Object `tfsdk:"port_config"`
+	CertificateConfig  types.Object `tfsdk:"certificate_config"`
+	PingConfig         types.Object `tfsdk:"ping_config"`
+	HTTPConfig         types.Object `tfsdk:"http_config"`
+	SNMPConfig         types.Object `tfsdk:"snmp_config"`
+	JSONAPIConfig      types.Object `tfsdk:"json_api_config"`
+	SMTPConfig         types.Object `tfsdk:"smtp_config"`
+	IMAPConfig         types.Object `tfsdk:"imap_config"`
+	POP3Config         types.Object `tfsdk:"pop3_config"`
+	SSHConfig          types.Object `tfsdk:"ssh_config"`
+	DatabaseConfig     types.Object `tfsdk:"database_config"`
+	RedisConfig        types.Object `tfsdk:"redis_config"`
+	NTPConfig          types.Object `tfsdk:"ntp_config"`
+	DomainExpiryConfig types.Object `tfsdk:"domain_expiry_config"`
+	BlacklistConfig    types.Object `tfsdk:"blacklist_config"`
+	GRPCConfig         types.Object `tfsdk:"grpc_config"`
+	WebSocketConfig    types.Object `tfsdk:"websocket_config"`
+	MQTTConfig         types.Object `tfsdk:"mqtt_config"`
+	LDAPConfig         types.Object `tfsdk:"ldap_config"`
+	DiskConfig         types.Object `tfsdk:"disk_config"`
+	LoadConfig         types.Object `tfsdk:"load_config"`
+	MemoryConfig       types.Object `tfsdk:"memory_config"`
+	ProcessConfig      types.Object `tfsdk:"process_config"`
+	LogConfig          types.Object `tfsdk:"log_config"`
+	ScriptConfig       types.Object `tfsdk:"script_config"`
+
+	Timeouts types.Object `tfsdk:"timeouts"`
 }
 
 func (r *checkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -90,27 +170,136 @@ func (r *checkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringOneOf(knownCheckTypes...),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the check. Defaults to a name derived by the server.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"config": schema.StringAttribute{
-				Description: "JSON config string.",
+				Description: "JSON config string. Computed from the typed *_config block when one is set.",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("{}"),
 				PlanModifiers: []planmodifier.String{
+					typedCheckConfigModifier{},
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"interval_seconds": schema.Int64Attribute{
-				Description: "Check interval in seconds.",
+				Description: "Check interval in seconds (10-86400).",
 				Optional:    true,
 				Computed:    true,
 				Default:     int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64Between(10, 86400),
+				},
 			},
 			"enabled": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(true),
 			},
+			"depends_on_check_id": schema.Int64Attribute{
+				Description: "ID of an upstream check. Alerts for this check are suppressed while the upstream check is failing.",
+				Optional:    true,
+			},
+			"template_id": schema.Int64Attribute{
+				Description: "ID of a tinymon_check_template the check takes its config and thresholds from. The template's type must match type. Cannot be combined with config, a typed *_config block or thresholds.",
+				Optional:    true,
+			},
+			"failures_before_alert": schema.Int64Attribute{
+				Description: "Consecutive failed runs before the check turns critical and notifies (1-20). Earlier failures are soft states that are retried without alerting.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64Between(1, 20),
+				},
+			},
+			"retry_interval_seconds": schema.Int64Attribute{
+				Description: "Interval between retries while the check is in a soft failed state (10-86400). Defaults to interval_seconds.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(10, 86400),
+				},
+			},
+			"locations": schema.ListAttribute{
+				Description: "Names of the probes (tinymon_probe) the check runs from. Runs from the server itself if unset.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tag_ids":      tagIDsSchema(),
+			"on_conflict":  onConflictSchema(),
+			"organization": organizationSchema(),
+			"wait_for_first_result": schema.BoolAttribute{
+				Description: "After creating the check, wait for its first result and fail the apply if it is critical.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"first_result_timeout_seconds": schema.Int64Attribute{
+				Description: "How long to wait for the first result when wait_for_first_result is set.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(300),
+			},
+			"last_status": schema.StringAttribute{
+				Description: "Status of the latest run (ok, warning, critical, unknown), or pending if the check has not run yet. Updated on refresh.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_run_at": schema.StringAttribute{
+				Description: "Time of the latest run in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_latency_ms": schema.Int64Attribute{
+				Description: "Latency of the latest run in milliseconds.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: checkBlocks(),
+	}
+}
+
+func checkBlocks() map[string]schema.Block {
+	blocks := typedCheckConfigBlocks()
+	blocks["warning_threshold"] = thresholdBlock("warning")
+	blocks["critical_threshold"] = thresholdBlock("critical")
+	blocks["flap_detection"] = flapDetectionBlock()
+	blocks["timeouts"] = timeoutsBlock()
+	return blocks
+}
+
+func (r *checkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"host_address": identityschema.StringAttribute{
+				Description:       "Address of the host the check belongs to.",
+				RequiredForImport: true,
+			},
+			"type": identityschema.StringAttribute{
+				Description:       "Check type.",
+				RequiredForImport: true,
+			},
+			"config": identityschema.StringAttribute{
+				Description:       "Check config as JSON. Only needed on import when the host has several checks of the same type.",
+				OptionalForImport: true,
+			},
 		},
 	}
 }
@@ -128,6 +317,134 @@ func (r *checkResource) Configure(_ context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
+func (r *checkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateTypedCheckConfigs(ctx, req.Config, &resp.Diagnostics)
+	validateCheckThresholds(ctx, req.Config, &resp.Diagnostics)
+	validateFlapDetection(ctx, req.Config, &resp.Diagnostics)
+	validateRetryInterval(ctx, req.Config, &resp.Diagnostics)
+	validateCheckTemplate(ctx, req.Config, &resp.Diagnostics)
+}
+
+// validateCheckTemplate rejects settings that a check template provides, so
+// a check cannot silently diverge from its template.
+func validateCheckTemplate(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var templateID types.Int64
+	diags.Append(config.GetAttribute(ctx, path.Root("template_id"), &templateID)...)
+	if diags.HasError() || templateID.IsNull() {
+		return
+	}
+
+	var rawConfig types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("config"), &rawConfig)...)
+	var conflicting []string
+	if !rawConfig.IsNull() {
+		conflicting = append(conflicting, "config")
+	}
+
+	blocks := []string{"warning_threshold", "critical_threshold"}
+	for _, tc := range typedCheckConfigs {
+		blocks = append(blocks, tc.name)
+	}
+	for _, name := range blocks {
+		var obj types.Object
+		diags.Append(config.GetAttribute(ctx, path.Root(name), &obj)...)
+		if !obj.IsNull() {
+			conflicting = append(conflicting, name)
+		}
+	}
+
+	for _, name := range conflicting {
+		diags.AddAttributeError(path.Root(name), "Conflicting check template",
+			fmt.Sprintf("%s cannot be set together with template_id; the check takes it from the template.", name))
+	}
+}
+
+// validateRetryInterval rejects retries that are slower than the regular
+// interval, which would delay alerts instead of confirming them quickly.
+func validateRetryInterval(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var interval, retryInterval types.Int64
+	diags.Append(config.GetAttribute(ctx, path.Root("interval_seconds"), &interval)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("retry_interval_seconds"), &retryInterval)...)
+	if diags.HasError() || retryInterval.IsNull() || retryInterval.IsUnknown() || interval.IsUnknown() {
+		return
+	}
+	if interval.IsNull() {
+		// The schema default.
+		interval = types.Int64Value(300)
+	}
+	if retryInterval.ValueInt64() > interval.ValueInt64() {
+		diags.AddAttributeError(path.Root("retry_interval_seconds"), "Invalid retry_interval_seconds",
+			fmt.Sprintf("retry_interval_seconds must not exceed interval_seconds (%d).", interval.ValueInt64()))
+	}
+}
+
+func (r *checkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	r.validateCheckTypeAgainstServer(ctx, req, resp)
+	r.validateIntervalAgainstServer(ctx, req, resp)
+	r.validateLocationsAgainstServer(ctx, req, resp)
+}
+
+func (r *checkResource) validateCheckTypeAgainstServer(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var checkType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &checkType)...)
+	if resp.Diagnostics.HasError() || checkType.IsNull() || checkType.IsUnknown() {
+		return
+	}
+
+	supported, err := r.client.CheckTypes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to discover check types",
+			fmt.Sprintf("Could not fetch supported check types from the server, skipping validation: %s", err))
+		return
+	}
+	if supported == nil {
+		return
+	}
+
+	if !slices.Contains(supported, checkType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Unsupported check type",
+			fmt.Sprintf("The TinyMon server does not support check type %q. Supported types: %s.",
+				checkType.ValueString(), strings.Join(supported, ", ")))
+	}
+}
+
+func (r *checkResource) validateIntervalAgainstServer(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var interval types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("interval_seconds"), &interval)...)
+	if resp.Diagnostics.HasError() || interval.IsNull() || interval.IsUnknown() {
+		return
+	}
+
+	limits, err := r.client.Limits(ctx)
+	if err != nil || limits == nil || limits.MinIntervalSeconds == 0 {
+		return
+	}
+
+	if interval.ValueInt64() < limits.MinIntervalSeconds {
+		resp.Diagnostics.AddAttributeWarning(path.Root("interval_seconds"), "Interval below server minimum",
+			fmt.Sprintf("interval_seconds is %d but the server's minimum is %d seconds; the server may reject or clamp it.",
+				interval.ValueInt64(), limits.MinIntervalSeconds))
+	}
+}
+
+func (r *checkResource) validateLocationsAgainstServer(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var locations types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("locations"), &locations)...)
+	if resp.Diagnostics.HasError() || locations.IsNull() {
+		return
+	}
+
+	if !r.client.Supports(featureProbes) {
+		resp.Diagnostics.AddAttributeError(path.Root("locations"), "Check locations not supported",
+			fmt.Sprintf("TinyMon %s does not support remote probes; %s or later is required for locations.",
+				r.client.ServerVersion(), featureProbes.since))
+	}
+}
+
 func (r *checkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan checkResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -136,27 +453,87 @@ func (r *checkResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	enabled := 1
-	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
-		enabled = 0
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "create")
+	defer cancel()
+	ctx = withOrganization(ctx, plan.Organization)
+
+	body, diags := checkToAPI(ctx, &plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	body := checkAPIRequest{
-		HostAddress:     plan.HostAddress.ValueString(),
-		Type:            plan.Type.ValueString(),
-		Config:          plan.Config.ValueString(),
-		IntervalSeconds: plan.IntervalSeconds.ValueInt64(),
-		Enabled:         enabled,
+	if !r.resolveConflict(ctx, &plan, &resp.Diagnostics) {
+		return
 	}
 
-	var result checkAPIResponse
-	if err := r.client.DoJSON("POST", "/api/push/checks", body, &result); err != nil {
-		resp.Diagnostics.AddError("Error creating check", err.Error())
+	result, err := r.client.UpsertCheck(ctx, body)
+	if err != nil {
+		addAPIFieldErrorDiagnostics(&resp.Diagnostics, "Error creating check", err, checkAPIFields)
 		return
 	}
 
-	mapCheckResponseToState(&result, &plan)
+	mapCheckResponseToState(result, &plan)
+	// The status is read on refresh. A new check has not run yet.
+	mapCheckResultToState(&tinymon.CheckResult{}, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, checkIdentity(&plan))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The check is saved to state first so a failed smoke test leaves it
+	// tainted rather than orphaned.
+	if plan.WaitForFirstResult.ValueBool() && plan.Enabled.ValueBool() {
+		timeout := time.Duration(plan.FirstResultTimeoutSeconds.ValueInt64()) * time.Second
+		checkResult, err := waitForCheckResult(ctx, r.client, result.ID, "", timeout)
+		if err != nil {
+			resp.Diagnostics.AddError("Error waiting for first check result", err.Error())
+			return
+		}
+		mapCheckResultToState(checkResult, &plan)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		if checkResult.Status == "critical" {
+			resp.Diagnostics.AddError("Check is critical",
+				fmt.Sprintf("The first result of check %d is critical: %s", result.ID, checkResult.Message))
+		}
+	}
+}
+
+// resolveConflict applies the on_conflict mode before a check is created. It
+// returns false when the check must not be created.
+func (r *checkResource) resolveConflict(ctx context.Context, plan *checkResourceModel, diags *diag.Diagnostics) bool {
+	mode := r.client.conflictMode(plan.OnConflict)
+	if mode == onConflictAdopt {
+		return true
+	}
+
+	existing, err := findCheck(tinymon.WithoutReadCache(ctx), r.client, plan)
+	if err != nil {
+		addAPIErrorDiagnostics(diags, "Error looking up existing check", err)
+		return false
+	}
+	if existing == nil {
+		return true
+	}
+
+	if mode == onConflictError {
+		diags.AddError("Check already exists",
+			fmt.Sprintf("Host %s already has a %s check with this config (ID %d). Import it, or set on_conflict to \"adopt\" or \"overwrite\".",
+				plan.HostAddress.ValueString(), plan.Type.ValueString(), existing.ID))
+		return false
+	}
+
+	target := tinymon.CheckKey{
+		HostAddress: plan.HostAddress.ValueString(),
+		Type:        existing.Type,
+		Config:      existing.Config,
+	}
+	if err := deleteCheck(ctx, r.client, types.Int64Value(existing.ID), target); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(diags, "Error deleting existing check", err)
+		return false
+	}
+	return true
 }
 
 func (r *checkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -167,20 +544,65 @@ func (r *checkResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	queryPath := fmt.Sprintf("/api/push/checks?host_address=%s&type=%s&config=%s",
-		url.QueryEscape(state.HostAddress.ValueString()),
-		url.QueryEscape(state.Type.ValueString()),
-		url.QueryEscape(state.Config.ValueString()),
-	)
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "read")
+	defer cancel()
+	ctx = withOrganization(ctx, state.Organization)
+
+	// Right after import only host_address, type and possibly config are
+	// known, so the check is looked up in the host's check list.
+	if state.ID.IsNull() || state.ID.IsUnknown() {
+		result, err := findCheck(ctx, r.client, &state)
+		if err != nil {
+			addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading check", err)
+			return
+		}
+		if result == nil {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		r.readIntoState(ctx, result, &state, resp)
+		return
+	}
 
-	var result checkAPIResponse
-	if err := r.client.DoJSON("GET", queryPath, nil, &result); err != nil {
-		resp.Diagnostics.AddError("Error reading check", err.Error())
+	// Reading by ID keeps working when the config drifts. Servers without
+	// id-based endpoints only support the composite query.
+	var result *tinymon.Check
+	var err error
+	if r.client.Supports(featureIDEndpoints) {
+		result, err = r.client.GetCheck(ctx, state.ID.ValueInt64())
+	} else {
+		result, err = r.client.GetCheckByKey(ctx, tinymon.CheckKey{
+			HostAddress: state.HostAddress.ValueString(),
+			Type:        state.Type.ValueString(),
+			Config:      state.Config.ValueString(),
+		})
+	}
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading check", err)
 		return
 	}
 
-	mapCheckResponseToState(&result, &state)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	r.readIntoState(ctx, result, &state, resp)
+}
+
+// readIntoState stores a check read from the server. Settings that only exist
+// in the provider are null after import; they get their defaults so the
+// imported check (and generated configuration) plans clean.
+func (r *checkResource) readIntoState(ctx context.Context, result *tinymon.Check, state *checkResourceModel, resp *resource.ReadResponse) {
+	mapCheckResponseToState(result, state)
+	if state.WaitForFirstResult.IsNull() {
+		state.WaitForFirstResult = types.BoolValue(false)
+	}
+	if state.FirstResultTimeoutSeconds.IsNull() {
+		state.FirstResultTimeoutSeconds = types.Int64Value(300)
+	}
+	r.readStatus(ctx, state, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, checkIdentity(state))...)
 }
 
 func (r *checkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -191,27 +613,26 @@ func (r *checkResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	enabled := 1
-	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
-		enabled = 0
-	}
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "update")
+	defer cancel()
+	ctx = withOrganization(ctx, plan.Organization)
 
-	body := checkAPIRequest{
-		HostAddress:     plan.HostAddress.ValueString(),
-		Type:            plan.Type.ValueString(),
-		Config:          plan.Config.ValueString(),
-		IntervalSeconds: plan.IntervalSeconds.ValueInt64(),
-		Enabled:         enabled,
+	body, diags := checkToAPI(ctx, &plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	var result checkAPIResponse
-	if err := r.client.DoJSON("POST", "/api/push/checks", body, &result); err != nil {
-		resp.Diagnostics.AddError("Error updating check", err.Error())
+	result, err := r.client.UpsertCheck(ctx, body)
+	if err != nil {
+		addAPIFieldErrorDiagnostics(&resp.Diagnostics, "Error updating check", err, checkAPIFields)
 		return
 	}
 
-	mapCheckResponseToState(&result, &plan)
+	mapCheckResponseToState(result, &plan)
+	// The status is read on refresh; the plan keeps the last known one.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, checkIdentity(&plan))...)
 }
 
 func (r *checkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -222,19 +643,35 @@ func (r *checkResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	body := checkDeleteRequest{
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "delete")
+	defer cancel()
+	ctx = withOrganization(ctx, state.Organization)
+
+	target := tinymon.CheckKey{
 		HostAddress: state.HostAddress.ValueString(),
 		Type:        state.Type.ValueString(),
 		Config:      state.Config.ValueString(),
 	}
-
-	if err := r.client.DoJSON("DELETE", "/api/push/checks", body, nil); err != nil {
-		resp.Diagnostics.AddError("Error deleting check", err.Error())
+	if err := deleteCheck(ctx, r.client, state.ID, target); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting check", err)
 		return
 	}
 }
 
 func (r *checkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import blocks with an identity instead of an ID (Terraform 1.12+).
+	if req.ID == "" {
+		var identity checkResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host_address"), identity.HostAddress)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), identity.Type)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config"), identity.Config)...)
+		return
+	}
+
 	parts := strings.SplitN(req.ID, "/", 3)
 	if len(parts) < 2 {
 		resp.Diagnostics.AddError("Invalid import ID",
@@ -245,17 +682,212 @@ func (r *checkResource) ImportState(ctx context.Context, req resource.ImportStat
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host_address"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), parts[1])...)
 
-	config := "{}"
+	// Without a config the check is matched by host and type alone; Read
+	// fills in the config from the server.
+	identity := checkResourceIdentityModel{
+		HostAddress: types.StringValue(parts[0]),
+		Type:        types.StringValue(parts[1]),
+		Config:      types.StringNull(),
+	}
 	if len(parts) == 3 {
-		config = parts[2]
+		identity.Config = types.StringValue(parts[2])
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config"), parts[2])...)
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config"), config)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
-func mapCheckResponseToState(apiResp *checkAPIResponse, state *checkResourceModel) {
+// deleteCheck deletes a check by ID, or by host, type and config when the ID
+// is unknown or the server has no ID-based endpoints.
+func deleteCheck(ctx context.Context, client *TinyMonClient, id types.Int64, target tinymon.CheckKey) error {
+	if !id.IsNull() && !id.IsUnknown() && client.Supports(featureIDEndpoints) {
+		return client.DeleteCheck(ctx, id.ValueInt64())
+	}
+	return client.DeleteCheckByKey(ctx, target)
+}
+
+// findCheck looks up a check in its host's check list by type and, if set,
+// config. It is used after import and to detect conflicts on create. It
+// returns nil when nothing matches and an error when the match is ambiguous.
+func findCheck(ctx context.Context, client *TinyMonClient, state *checkResourceModel) (*tinymon.Check, error) {
+	checks, err := client.ListChecks(ctx, tinymon.ListChecksOptions{HostAddress: state.HostAddress.ValueString()})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var matches []*tinymon.Check
+	for i := range checks {
+		check := &checks[i]
+		if check.Type != state.Type.ValueString() {
+			continue
+		}
+		if !state.Config.IsNull() && !jsonEqual(check.Config, state.Config.ValueString()) {
+			continue
+		}
+		matches = append(matches, check)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("host %s has %d checks of type %s; import with host_address/type/config to select one",
+			state.HostAddress.ValueString(), len(matches), state.Type.ValueString())
+	}
+}
+
+// checkResourceIdentityModel is the resource identity of a check. All three
+// attributes force replacement, so the identity never changes in place.
+type checkResourceIdentityModel struct {
+	HostAddress types.String `tfsdk:"host_address"`
+	Type        types.String `tfsdk:"type"`
+	Config      types.String `tfsdk:"config"`
+}
+
+// checkToAPI builds the upsert request. Write-only secrets are read from
+// config because the plan holds them as null.
+func checkToAPI(ctx context.Context, plan *checkResourceModel, config tfsdk.Config) (tinymon.CheckRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+
+	// An empty list clears locations set earlier.
+	locations := []string{}
+	if !plan.Locations.IsNull() {
+		diags.Append(plan.Locations.ElementsAs(ctx, &locations, false)...)
+	}
+
+	body := tinymon.CheckRequest{
+		HostAddress:          plan.HostAddress.ValueString(),
+		Type:                 plan.Type.ValueString(),
+		Name:                 plan.Name.ValueString(),
+		Config:               plan.Config.ValueString(),
+		IntervalSeconds:      plan.IntervalSeconds.ValueInt64(),
+		Enabled:              enabled,
+		DependsOnCheckID:     plan.DependsOnCheckID.ValueInt64(),
+		TemplateID:           plan.TemplateID.ValueInt64(),
+		FailuresBeforeAlert:  plan.FailuresBeforeAlert.ValueInt64(),
+		RetryIntervalSeconds: plan.RetryIntervalSeconds.ValueInt64(),
+		Locations:            locations,
+		TagIDs:               idSetFromPlan(ctx, plan.TagIDs, &diags),
+		WarningThreshold:     thresholdToAPI(plan.WarningThreshold),
+		CriticalThreshold:    thresholdToAPI(plan.CriticalThreshold),
+		FlapDetection:        flapDetectionToAPI(plan.FlapDetection),
+		Secrets:              typedCheckConfigSecrets(ctx, config, &diags),
+	}
+	return body, diags
+}
+
+func checkIdentity(state *checkResourceModel) checkResourceIdentityModel {
+	return checkResourceIdentityModel{
+		HostAddress: state.HostAddress,
+		Type:        state.Type,
+		Config:      state.Config,
+	}
+}
+
+func mapCheckResponseToState(apiResp *tinymon.Check, state *checkResourceModel) {
 	state.ID = types.Int64Value(apiResp.ID)
+	if apiResp.HostAddress != "" {
+		state.HostAddress = types.StringValue(apiResp.HostAddress)
+	}
 	state.Type = types.StringValue(apiResp.Type)
+	state.Name = types.StringValue(apiResp.Name)
 	state.Config = types.StringValue(apiResp.Config)
+	state.WarningThreshold = thresholdToState(apiResp.WarningThreshold)
+	state.CriticalThreshold = thresholdToState(apiResp.CriticalThreshold)
+	if apiResp.TemplateID != 0 {
+		// The server reports the template's config and thresholds. They
+		// cannot be set alongside template_id (see validateCheckTemplate), so
+		// state keeps the config default and no thresholds.
+		state.Config = types.StringValue("{}")
+		state.WarningThreshold = thresholdToState(nil)
+		state.CriticalThreshold = thresholdToState(nil)
+	}
 	state.IntervalSeconds = types.Int64Value(apiResp.IntervalSeconds)
 	state.Enabled = types.BoolValue(apiResp.Enabled != 0)
+	state.DependsOnCheckID = int64OrNull(apiResp.DependsOnCheckID)
+	state.TemplateID = int64OrNull(apiResp.TemplateID)
+	state.FailuresBeforeAlert = types.Int64Value(apiResp.FailuresBeforeAlert)
+	state.RetryIntervalSeconds = int64OrNull(apiResp.RetryIntervalSeconds)
+	state.TagIDs = idSetToState(apiResp.TagIDs, state.TagIDs)
+	state.FlapDetection = flapDetectionToState(apiResp.FlapDetection, state.FlapDetection)
+
+	// No locations means "run from the server". Keep whichever of unset or
+	// [] the configuration used so both plan clean.
+	if len(apiResp.Locations) == 0 && (state.Locations.IsNull() || len(state.Locations.Elements()) == 0) {
+		if state.Locations.IsNull() {
+			// Also gives a zero-valued model its element type.
+			state.Locations = types.ListNull(types.StringType)
+		}
+		return
+	}
+	state.Locations = stringListValue(apiResp.Locations)
+}
+
+// readStatus fills the last_* attributes from the check's latest result. The
+// status is informational, so a failed request only warns and keeps the
+// previous values.
+func (r *checkResource) readStatus(ctx context.Context, state *checkResourceModel, diags *diag.Diagnostics) {
+	result, err := r.client.GetCheckResult(ctx, state.ID.ValueInt64())
+	if isNotFound(err) {
+		result, err = &tinymon.CheckResult{}, nil
+	}
+	if err != nil {
+		diags.AddWarning("Error reading check status",
+			fmt.Sprintf("The last_* attributes of check %d were not refreshed: %s", state.ID.ValueInt64(), err))
+		return
+	}
+	mapCheckResultToState(result, state)
+}
+
+// mapCheckResultToState stores a check result in the last_* attributes. A
+// result without checked_at means the check has not run yet.
+func mapCheckResultToState(result *tinymon.CheckResult, state *checkResourceModel) {
+	if result.CheckedAt == "" {
+		state.LastStatus = types.StringValue("pending")
+		state.LastRunAt = types.StringNull()
+		state.LastLatencyMS = types.Int64Null()
+		return
+	}
+	state.LastStatus = types.StringValue(result.Status)
+	state.LastRunAt = types.StringValue(result.CheckedAt)
+	state.LastLatencyMS = types.Int64Value(result.LatencyMS)
+}
+
+// waitForCheckResult polls the latest result of a check until one other than
+// the result checked at previousCheckedAt is available or timeout expires.
+// Pass an empty previousCheckedAt to wait for the first result.
+func waitForCheckResult(ctx context.Context, client *TinyMonClient, id int64, previousCheckedAt string, timeout time.Duration) (*tinymon.CheckResult, error) {
+	ctx, cancel := context.WithTimeout(tinymon.WithoutReadCache(ctx), timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		result, err := client.GetCheckResult(ctx, id)
+		switch {
+		case err == nil && result.CheckedAt != "" && result.CheckedAt != previousCheckedAt:
+			return result, nil
+		case err != nil && !isNotFound(err):
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("no result for check %d within %s", id, timeout)
+			}
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("no result for check %d within %s", id, timeout)
+		case <-ticker.C:
+		}
+	}
 }
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon/tinymontest"
+)
+
+func TestCheckResourceCRUD(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.AddHost(tinymon.HostRequest{Address: "web1.example.com", Enabled: 1})
+	h := newResourceHarness(t, &checkResource{client: client})
+
+	state := h.create(map[string]tftypes.Value{
+		"host_address":     tftypes.NewValue(tftypes.String, "web1.example.com"),
+		"type":             tftypes.NewValue(tftypes.String, "http"),
+		"name":             tftypes.NewValue(tftypes.String, "Homepage"),
+		"config":           tftypes.NewValue(tftypes.String, `{"url":"https://web1.example.com/"}`),
+		"interval_seconds": tftypes.NewValue(tftypes.Number, 60),
+		"enabled":          tftypes.NewValue(tftypes.Bool, true),
+	})
+	checks := srv.Checks()
+	if len(checks) != 1 || checks[0].Name != "Homepage" || checks[0].IntervalSeconds != 60 || checks[0].Enabled != 1 {
+		t.Fatalf("server checks after create: %+v", checks)
+	}
+	var id types.Int64
+	getAttribute(t, state, "id", &id)
+	if id.ValueInt64() != checks[0].ID {
+		t.Fatalf("id in state = %s, want %d", id, checks[0].ID)
+	}
+
+	state = h.update(state, map[string]tftypes.Value{
+		"host_address":     tftypes.NewValue(tftypes.String, "web1.example.com"),
+		"type":             tftypes.NewValue(tftypes.String, "http"),
+		"name":             tftypes.NewValue(tftypes.String, "Homepage"),
+		"config":           tftypes.NewValue(tftypes.String, `{"url":"https://web1.example.com/"}`),
+		"interval_seconds": tftypes.NewValue(tftypes.Number, 300),
+		"enabled":          tftypes.NewValue(tftypes.Bool, true),
+	})
+	checks = srv.Checks()
+	if len(checks) != 1 || checks[0].ID != id.ValueInt64() || checks[0].IntervalSeconds != 300 {
+		t.Fatalf("server checks after update: %+v", checks)
+	}
+
+	state, ok := h.read(state)
+	if !ok {
+		t.Fatal("check removed from state on read")
+	}
+	var interval types.Int64
+	getAttribute(t, state, "interval_seconds", &interval)
+	if interval.ValueInt64() != 300 {
+		t.Fatalf("interval_seconds after read = %s, want 300", interval)
+	}
+
+	h.delete(state)
+	if checks := srv.Checks(); len(checks) != 0 {
+		t.Fatalf("server checks after delete: %+v", checks)
+	}
+
+	// A check deleted outside Terraform is removed from state.
+	if _, ok := h.read(state); ok {
+		t.Fatal("deleted check kept in state on read")
+	}
+}
+
+func TestCheckResourceTemplate(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.AddHost(tinymon.HostRequest{Address: "web1.example.com", Enabled: 1})
+	latency := int64(500)
+	template := srv.AddCheckTemplate(tinymontest.CheckTemplate{
+		Type:             "http",
+		Config:           `{"url":"https://web1.example.com/"}`,
+		WarningThreshold: &tinymon.CheckThreshold{LatencyMS: &latency},
+	})
+	h := newResourceHarness(t, &checkResource{client: client})
+
+	// Config is the schema default; the template fills in the real one.
+	attrs := map[string]tftypes.Value{
+		"host_address":     tftypes.NewValue(tftypes.String, "web1.example.com"),
+		"type":             tftypes.NewValue(tftypes.String, "http"),
+		"name":             tftypes.NewValue(tftypes.String, "Homepage"),
+		"config":           tftypes.NewValue(tftypes.String, "{}"),
+		"interval_seconds": tftypes.NewValue(tftypes.Number, 60),
+		"enabled":          tftypes.NewValue(tftypes.Bool, true),
+		"template_id":      tftypes.NewValue(tftypes.Number, template.ID),
+	}
+	assertPlanned := func(state tfsdk.State) {
+		t.Helper()
+		var config types.String
+		var warning types.Object
+		getAttribute(t, state, "config", &config)
+		getAttribute(t, state, "warning_threshold", &warning)
+		if config.ValueString() != "{}" || !warning.IsNull() {
+			t.Fatalf("state has config %s and warning_threshold %s, want the planned {} and null", config, warning)
+		}
+	}
+
+	state := h.create(attrs)
+	assertPlanned(state)
+	checks := srv.Checks()
+	if len(checks) != 1 || checks[0].Config != template.Config || checks[0].WarningThreshold == nil {
+		t.Fatalf("server checks after create: %+v", checks)
+	}
+
+	state, ok := h.read(state)
+	if !ok {
+		t.Fatal("check removed from state on read")
+	}
+	assertPlanned(state)
+
+	attrs["interval_seconds"] = tftypes.NewValue(tftypes.Number, 300)
+	state = h.update(state, attrs)
+	assertPlanned(state)
+	checks = srv.Checks()
+	if len(checks) != 1 || checks[0].IntervalSeconds != 300 {
+		t.Fatalf("server checks after update: %+v", checks)
+	}
+}
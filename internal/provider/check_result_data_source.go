@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+var _ datasource.DataSource = &checkResultDataSource{}
+
+func NewCheckResultDataSource() datasource.DataSource {
+	return &checkResultDataSource{}
+}
+
+type checkResultDataSource struct {
+	client *TinyMonClient
+}
+
+type checkResultDataSourceModel struct {
+	CheckID   types.Int64  `tfsdk:"check_id"`
+	Status    types.String `tfsdk:"status"`
+	LatencyMS types.Int64  `tfsdk:"latency_ms"`
+	Message   types.String `tfsdk:"message"`
+	CheckedAt types.String `tfsdk:"checked_at"`
+}
+
+func (d *checkResultDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_result"
+}
+
+func (d *checkResultDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the latest result of a check.",
+		Attributes: map[string]schema.Attribute{
+			"check_id": schema.Int64Attribute{
+				Description: "ID of the check.",
+				Required:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Latest status (ok, warning, critical, unknown), or pending if the check has not run yet.",
+				Computed:    true,
+			},
+			"latency_ms": schema.Int64Attribute{
+				Description: "Latency of the latest run in milliseconds.",
+				Computed:    true,
+			},
+			"message": schema.StringAttribute{
+				Description: "Message of the latest run.",
+				Computed:    true,
+			},
+			"checked_at": schema.StringAttribute{
+				Description: "Time of the latest run in RFC 3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *checkResultDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *checkResultDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state checkResultDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.GetCheckResult(ctx, state.CheckID.ValueInt64())
+	if isNotFound(err) {
+		result, err = &tinymon.CheckResult{}, nil
+	}
+	if err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading check result", err)
+		return
+	}
+
+	if result.CheckedAt == "" {
+		state.Status = types.StringValue("pending")
+		state.LatencyMS = types.Int64Null()
+		state.Message = types.StringNull()
+		state.CheckedAt = types.StringNull()
+	} else {
+		state.Status = types.StringValue(result.Status)
+		state.LatencyMS = types.Int64Value(result.LatencyMS)
+		state.Message = types.StringValue(result.Message)
+		state.CheckedAt = types.StringValue(result.CheckedAt)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
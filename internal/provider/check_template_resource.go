@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+var (
+	_ resource.Resource                   = &checkTemplateResource{}
+	_ resource.ResourceWithImportState    = &checkTemplateResource{}
+	_ resource.ResourceWithValidateConfig = &checkTemplateResource{}
+)
+
+func NewCheckTemplateResource() resource.Resource {
+	return &checkTemplateResource{}
+}
+
+type checkTemplateResource struct {
+	client *TinyMonClient
+}
+
+type checkTemplateResourceModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Type              types.String `tfsdk:"type"`
+	Config            types.String `tfsdk:"config"`
+	WarningThreshold  types.Object `tfsdk:"warning_threshold"`
+	CriticalThreshold types.Object `tfsdk:"critical_threshold"`
+}
+
+type checkTemplateAPIRequest struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Config string `json:"config"`
+	// Thresholds are sent as null when unset, which clears them.
+	WarningThreshold  *tinymon.CheckThreshold `json:"warning_threshold"`
+	CriticalThreshold *tinymon.CheckThreshold `json:"critical_threshold"`
+}
+
+type checkTemplateAPIResponse struct {
+	ID                int64                   `json:"id"`
+	Name              string                  `json:"name"`
+	Type              string                  `json:"type"`
+	Config            string                  `json:"config"`
+	WarningThreshold  *tinymon.CheckThreshold `json:"warning_threshold"`
+	CriticalThreshold *tinymon.CheckThreshold `json:"critical_threshold"`
+}
+
+func (r *checkTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_template"
+}
+
+func (r *checkTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a reusable check definition. Checks created with template_id take their config and thresholds from the template, so a template change applies to all of them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the template.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Check type of the template. Checks using the template must have the same type. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringOneOf(knownCheckTypes...),
+				},
+			},
+			"config": schema.StringAttribute{
+				Description: "JSON config of the checks, e.g. from jsonencode() or provider::tinymon::http_check_config().",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("{}"),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"warning_threshold":  thresholdBlock("warning"),
+			"critical_threshold": thresholdBlock("critical"),
+		},
+	}
+}
+
+func (r *checkTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *checkTemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateCheckThresholds(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (r *checkTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan checkTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result checkTemplateAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/check_templates", checkTemplateToAPI(&plan), &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating check template", err)
+		return
+	}
+
+	mapCheckTemplateResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *checkTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state checkTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/check_templates/%d", state.ID.ValueInt64())
+
+	var result checkTemplateAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading check template", err)
+		return
+	}
+
+	mapCheckTemplateResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *checkTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan checkTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/check_templates/%d", plan.ID.ValueInt64())
+
+	var result checkTemplateAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, checkTemplateToAPI(&plan), &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating check template", err)
+		return
+	}
+
+	mapCheckTemplateResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *checkTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state checkTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The server refuses to delete a template that checks still use.
+	apiPath := fmt.Sprintf("/api/push/check_templates/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting check template", err)
+		return
+	}
+}
+
+func (r *checkTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric check template ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func checkTemplateToAPI(plan *checkTemplateResourceModel) checkTemplateAPIRequest {
+	return checkTemplateAPIRequest{
+		Name:              plan.Name.ValueString(),
+		Type:              plan.Type.ValueString(),
+		Config:            plan.Config.ValueString(),
+		WarningThreshold:  thresholdToAPI(plan.WarningThreshold),
+		CriticalThreshold: thresholdToAPI(plan.CriticalThreshold),
+	}
+}
+
+func mapCheckTemplateResponseToState(apiResp *checkTemplateAPIResponse, state *checkTemplateResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.Type = types.StringValue(apiResp.Type)
+	// The server may reformat the JSON; keep the configured text when it
+	// is equivalent.
+	if state.Config.IsNull() || state.Config.IsUnknown() || !jsonEqual(apiResp.Config, state.Config.ValueString()) {
+		state.Config = types.StringValue(apiResp.Config)
+	}
+	state.WarningThreshold = thresholdToState(apiResp.WarningThreshold)
+	state.CriticalThreshold = thresholdToState(apiResp.CriticalThreshold)
+}
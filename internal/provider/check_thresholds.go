@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+var checkThresholdAttrTypes = map[string]attr.Type{
+	"latency_ms":          types.Int64Type,
+	"packet_loss_percent": types.Float64Type,
+	"response_size_bytes": types.Int64Type,
+}
+
+// thresholdBlock is the warning_threshold or critical_threshold block of
+// checks. The check turns to level once any configured metric exceeds it.
+func thresholdBlock(level string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: fmt.Sprintf("Limits above which the check is %s. Set at least one.", level),
+		Attributes: map[string]schema.Attribute{
+			"latency_ms": schema.Int64Attribute{
+				Description: "Response time in milliseconds.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 3600000),
+				},
+			},
+			"packet_loss_percent": schema.Float64Attribute{
+				Description: "Packet loss in percent (ping checks).",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64Between(0, 100),
+				},
+			},
+			"response_size_bytes": schema.Int64Attribute{
+				Description: "Response body size in bytes (http and content checks).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 1<<40),
+				},
+			},
+		},
+	}
+}
+
+// validateCheckThresholds rejects empty threshold blocks and warning limits
+// that are not below the matching critical limit.
+func validateCheckThresholds(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var warning, critical types.Object
+	diags.Append(config.GetAttribute(ctx, path.Root("warning_threshold"), &warning)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("critical_threshold"), &critical)...)
+	if diags.HasError() {
+		return
+	}
+
+	for _, block := range []struct {
+		name string
+		obj  types.Object
+	}{{"warning_threshold", warning}, {"critical_threshold", critical}} {
+		if block.obj.IsNull() || block.obj.IsUnknown() {
+			continue
+		}
+		empty := true
+		for _, value := range block.obj.Attributes() {
+			if !value.IsNull() {
+				empty = false
+			}
+		}
+		if empty {
+			diags.AddAttributeError(path.Root(block.name), "Empty threshold",
+				fmt.Sprintf("%s must set at least one of latency_ms, packet_loss_percent and response_size_bytes.", block.name))
+		}
+	}
+
+	if warning.IsNull() || warning.IsUnknown() || critical.IsNull() || critical.IsUnknown() {
+		return
+	}
+	for _, name := range []string{"latency_ms", "packet_loss_percent", "response_size_bytes"} {
+		w, wok := thresholdNumber(warning.Attributes()[name])
+		c, cok := thresholdNumber(critical.Attributes()[name])
+		if wok && cok && w >= c {
+			diags.AddAttributeError(path.Root("warning_threshold").AtName(name), "Invalid threshold",
+				fmt.Sprintf("warning_threshold.%s must be lower than critical_threshold.%s.", name, name))
+		}
+	}
+}
+
+// thresholdNumber returns a known threshold value as float64.
+func thresholdNumber(value attr.Value) (float64, bool) {
+	switch v := value.(type) {
+	case types.Int64:
+		if v.IsNull() || v.IsUnknown() {
+			return 0, false
+		}
+		return float64(v.ValueInt64()), true
+	case types.Float64:
+		if v.IsNull() || v.IsUnknown() {
+			return 0, false
+		}
+		return v.ValueFloat64(), true
+	}
+	return 0, false
+}
+
+// thresholdToAPI returns nil for an absent block, which clears the threshold
+// on the server.
+func thresholdToAPI(obj types.Object) *tinymon.CheckThreshold {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	attrs := obj.Attributes()
+	return &tinymon.CheckThreshold{
+		LatencyMS:         attrs["latency_ms"].(types.Int64).ValueInt64Pointer(),
+		PacketLossPercent: attrs["packet_loss_percent"].(types.Float64).ValueFloat64Pointer(),
+		ResponseSizeBytes: attrs["response_size_bytes"].(types.Int64).ValueInt64Pointer(),
+	}
+}
+
+func thresholdToState(threshold *tinymon.CheckThreshold) types.Object {
+	if threshold == nil {
+		return types.ObjectNull(checkThresholdAttrTypes)
+	}
+	return types.ObjectValueMust(checkThresholdAttrTypes, map[string]attr.Value{
+		"latency_ms":          types.Int64PointerValue(threshold.LatencyMS),
+		"packet_loss_percent": types.Float64PointerValue(threshold.PacketLossPercent),
+		"response_size_bytes": types.Int64PointerValue(threshold.ResponseSizeBytes),
+	})
+}
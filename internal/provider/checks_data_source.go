@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+var (
+	_ datasource.DataSource                   = &checksDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &checksDataSource{}
+)
+
+func NewChecksDataSource() datasource.DataSource {
+	return &checksDataSource{}
+}
+
+type checksDataSource struct {
+	client *TinyMonClient
+}
+
+type checksDataSourceModel struct {
+	HostAddress types.String                 `tfsdk:"host_address"`
+	Topic       types.String                 `tfsdk:"topic"`
+	Tags        types.Map                    `tfsdk:"tags"`
+	Checks      []checksDataSourceCheckModel `tfsdk:"checks"`
+}
+
+type checksDataSourceCheckModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	HostAddress     types.String `tfsdk:"host_address"`
+	Type            types.String `tfsdk:"type"`
+	Name            types.String `tfsdk:"name"`
+	Config          types.String `tfsdk:"config"`
+	IntervalSeconds types.Int64  `tfsdk:"interval_seconds"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *checksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_checks"
+}
+
+func (d *checksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all checks for a host address, topic or host tags.",
+		Attributes: map[string]schema.Attribute{
+			"host_address": schema.StringAttribute{
+				Description: "Only return checks of the host with this address.",
+				Optional:    true,
+			},
+			"topic": schema.StringAttribute{
+				Description: "Only return checks of hosts in this topic.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Only return checks of hosts carrying all of these tags.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"checks": schema.ListNestedAttribute{
+				Description: "Matching checks.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"host_address": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"config": schema.StringAttribute{
+							Computed: true,
+						},
+						"interval_seconds": schema.Int64Attribute{
+							Computed: true,
+						},
+						"enabled": schema.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *checksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *checksDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config checksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.HostAddress.IsNull() && config.Topic.IsNull() && config.Tags.IsNull() {
+		resp.Diagnostics.AddError("Missing filter",
+			"At least one of host_address, topic or tags must be set.")
+	}
+}
+
+func (d *checksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state checksDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := map[string]string{}
+	if !state.Tags.IsNull() {
+		resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	result, err := d.client.ListChecks(ctx, tinymon.ListChecksOptions{
+		HostAddress: state.HostAddress.ValueString(),
+		Topic:       state.Topic.ValueString(),
+		Tags:        tags,
+	})
+	if err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error listing checks", err)
+		return
+	}
+
+	state.Checks = make([]checksDataSourceCheckModel, 0, len(result))
+	for _, check := range result {
+		state.Checks = append(state.Checks, checksDataSourceCheckModel{
+			ID:              types.Int64Value(check.ID),
+			HostAddress:     types.StringValue(check.HostAddress),
+			Type:            types.StringValue(check.Type),
+			Name:            types.StringValue(check.Name),
+			Config:          types.StringValue(check.Config),
+			IntervalSeconds: types.Int64Value(check.IntervalSeconds),
+			Enabled:         types.BoolValue(check.Enabled != 0),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
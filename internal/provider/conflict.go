@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// On-conflict modes decide what Create does when the object already exists
+// on the server, e.g. because it was set up by hand.
+const (
+	// onConflictError refuses to create; the object has to be imported.
+	onConflictError = "error"
+	// onConflictAdopt updates the existing object in place, keeping its ID
+	// and history. This is the Push API's upsert behavior.
+	onConflictAdopt = "adopt"
+	// onConflictOverwrite deletes the existing object and creates a new one.
+	onConflictOverwrite = "overwrite"
+)
+
+var onConflictModes = []string{onConflictError, onConflictAdopt, onConflictOverwrite}
+
+// onConflictSchema is the on_conflict attribute of resources with a natural
+// key (hosts and checks).
+func onConflictSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "What to do when the object already exists on create: error, adopt (update it in place) or overwrite (delete and recreate). Defaults to the provider's on_conflict.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringOneOf(onConflictModes...),
+		},
+	}
+}
+
+// conflictMode returns the mode for a resource: its own on_conflict if set,
+// otherwise the provider's.
+func (c *TinyMonClient) conflictMode(override types.String) string {
+	if !override.IsNull() && !override.IsUnknown() {
+		return override.ValueString()
+	}
+	if c.onConflict != "" {
+		return c.onConflict
+	}
+	return onConflictAdopt
+}
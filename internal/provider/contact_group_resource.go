@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ resource.Resource                   = &contactGroupResource{}
+	_ resource.ResourceWithImportState    = &contactGroupResource{}
+	_ resource.ResourceWithValidateConfig = &contactGroupResource{}
+)
+
+var contactGroupRotationAttrTypes = map[string]attr.Type{
+	"period":       types.StringType,
+	"handoff_time": types.StringType,
+	"timezone":     types.StringType,
+}
+
+func NewContactGroupResource() resource.Resource {
+	return &contactGroupResource{}
+}
+
+type contactGroupResource struct {
+	client *TinyMonClient
+}
+
+type contactGroupResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	ContactIDs      types.List   `tfsdk:"contact_ids"`
+	Rotation        types.Object `tfsdk:"rotation"`
+	OnCallContactID types.Int64  `tfsdk:"on_call_contact_id"`
+}
+
+type contactGroupRotationModel struct {
+	Period      types.String `tfsdk:"period"`
+	HandoffTime types.String `tfsdk:"handoff_time"`
+	Timezone    types.String `tfsdk:"timezone"`
+}
+
+type contactGroupAPIRequest struct {
+	Name        string                    `json:"name"`
+	Description string                    `json:"description,omitempty"`
+	ContactIDs  []int64                   `json:"contact_ids"`
+	Rotation    *contactGroupRotationJSON `json:"rotation"`
+}
+
+type contactGroupAPIResponse struct {
+	ID              int64                     `json:"id"`
+	Name            string                    `json:"name"`
+	Description     string                    `json:"description"`
+	ContactIDs      []int64                   `json:"contact_ids"`
+	Rotation        *contactGroupRotationJSON `json:"rotation"`
+	OnCallContactID int64                     `json:"on_call_contact_id"`
+}
+
+type contactGroupRotationJSON struct {
+	Period      string `json:"period"`
+	HandoffTime string `json:"handoff_time,omitempty"`
+	Timezone    string `json:"timezone,omitempty"`
+}
+
+func (r *contactGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contact_group"
+}
+
+func (r *contactGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a group of tinymon_contact resources that alerts can be routed to as a whole. With a rotation block only the contact on call is notified.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Group name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "What the group is for.",
+				Optional:    true,
+			},
+			"contact_ids": schema.ListAttribute{
+				Description: "IDs of the member contacts. With a rotation, the order is the on-call order.",
+				ElementType: types.Int64Type,
+				Required:    true,
+			},
+			"on_call_contact_id": schema.Int64Attribute{
+				Description: "ID of the contact currently on call. Null without a rotation.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			// Attributes are Optional because the framework enforces Required
+			// attributes of a single nested block even when it is absent.
+			"rotation": schema.SingleNestedBlock{
+				Description: "Rotates on-call duty through contact_ids in order. Without it, all members are notified.",
+				Attributes: map[string]schema.Attribute{
+					"period": schema.StringAttribute{
+						Description: "How long each contact is on call (daily, weekly). Required.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringOneOf("daily", "weekly"),
+						},
+					},
+					"handoff_time": schema.StringAttribute{
+						Description: "Time of day as HH:MM at which the next contact takes over. Defaults to 09:00 on the server.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringMatches(clockTime, "a time of day like 09:00"),
+						},
+					},
+					"timezone": schema.StringAttribute{
+						Description: "IANA time zone of handoff_time. Defaults to the server's time zone.",
+						Optional:    true,
+						Validators: []validator.String{
+							timezone(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *contactGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *contactGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config contactGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ContactIDs.IsUnknown() && !config.ContactIDs.IsNull() {
+		seen := map[int64]bool{}
+		for _, elem := range config.ContactIDs.Elements() {
+			id, ok := elem.(types.Int64)
+			if !ok || id.IsUnknown() || id.IsNull() {
+				continue
+			}
+			if seen[id.ValueInt64()] {
+				resp.Diagnostics.AddAttributeError(path.Root("contact_ids"), "Duplicate contact",
+					fmt.Sprintf("Contact %d is listed more than once.", id.ValueInt64()))
+			}
+			seen[id.ValueInt64()] = true
+		}
+	}
+
+	if !config.Rotation.IsNull() && !config.Rotation.IsUnknown() {
+		if period, ok := config.Rotation.Attributes()["period"]; ok && period.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("rotation").AtName("period"), "Missing required argument",
+				"The argument \"period\" is required in rotation.")
+		}
+	}
+}
+
+func (r *contactGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan contactGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := contactGroupToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result contactGroupAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/contact_groups", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating contact group", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapContactGroupResponseToState(ctx, &result, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *contactGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state contactGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/contact_groups/%d", state.ID.ValueInt64())
+
+	var result contactGroupAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading contact group", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapContactGroupResponseToState(ctx, &result, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *contactGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan contactGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := contactGroupToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/contact_groups/%d", plan.ID.ValueInt64())
+
+	var result contactGroupAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating contact group", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapContactGroupResponseToState(ctx, &result, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *contactGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state contactGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/contact_groups/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting contact group", err)
+		return
+	}
+}
+
+func (r *contactGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric contact group ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// contactGroupToAPI sends rotation as null when the block is absent, which
+// turns a rotation set earlier off.
+func contactGroupToAPI(ctx context.Context, plan *contactGroupResourceModel) (contactGroupAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	contactIDs := []int64{}
+	diags.Append(plan.ContactIDs.ElementsAs(ctx, &contactIDs, false)...)
+
+	body := contactGroupAPIRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		ContactIDs:  contactIDs,
+	}
+
+	if !plan.Rotation.IsNull() {
+		var rotation contactGroupRotationModel
+		diags.Append(plan.Rotation.As(ctx, &rotation, basetypes.ObjectAsOptions{})...)
+		body.Rotation = &contactGroupRotationJSON{
+			Period:      rotation.Period.ValueString(),
+			HandoffTime: rotation.HandoffTime.ValueString(),
+			Timezone:    rotation.Timezone.ValueString(),
+		}
+	}
+
+	return body, diags
+}
+
+func mapContactGroupResponseToState(ctx context.Context, apiResp *contactGroupAPIResponse, state *contactGroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.Description = stringOrNull(apiResp.Description)
+	state.OnCallContactID = int64OrNull(apiResp.OnCallContactID)
+
+	// A nil slice would become a null list; the attribute is required.
+	contactIDs, d := types.ListValueFrom(ctx, types.Int64Type, append([]int64{}, apiResp.ContactIDs...))
+	diags.Append(d...)
+	state.ContactIDs = contactIDs
+
+	if apiResp.Rotation == nil {
+		state.Rotation = types.ObjectNull(contactGroupRotationAttrTypes)
+		return diags
+	}
+
+	// handoff_time and timezone fall back to server defaults; keep them unset
+	// when the configuration left them out.
+	var current contactGroupRotationModel
+	if !state.Rotation.IsNull() && !state.Rotation.IsUnknown() {
+		diags.Append(state.Rotation.As(ctx, &current, basetypes.ObjectAsOptions{})...)
+	}
+	handoffTime := stringOrNull(apiResp.Rotation.HandoffTime)
+	if current.HandoffTime.IsNull() && !state.Rotation.IsNull() {
+		handoffTime = types.StringNull()
+	}
+	tz := stringOrNull(apiResp.Rotation.Timezone)
+	if current.Timezone.IsNull() && !state.Rotation.IsNull() {
+		tz = types.StringNull()
+	}
+
+	rotation, d := types.ObjectValueFrom(ctx, contactGroupRotationAttrTypes, contactGroupRotationModel{
+		Period:      types.StringValue(apiResp.Rotation.Period),
+		HandoffTime: handoffTime,
+		Timezone:    tz,
+	})
+	diags.Append(d...)
+	state.Rotation = rotation
+	return diags
+}
@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ resource.Resource                   = &contactResource{}
+	_ resource.ResourceWithImportState    = &contactResource{}
+	_ resource.ResourceWithValidateConfig = &contactResource{}
+)
+
+// contactPhone matches phone numbers in E.164 format.
+var contactPhone = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// contactEmail only rules out obvious typos; the server verifies addresses.
+var contactEmail = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// clockTime matches a time of day as HH:MM.
+var clockTime = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+var contactQuietHoursAttrTypes = map[string]attr.Type{
+	"start":          types.StringType,
+	"end":            types.StringType,
+	"allow_critical": types.BoolType,
+}
+
+func NewContactResource() resource.Resource {
+	return &contactResource{}
+}
+
+type contactResource struct {
+	client *TinyMonClient
+}
+
+type contactResourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Email      types.String `tfsdk:"email"`
+	Phone      types.String `tfsdk:"phone"`
+	Timezone   types.String `tfsdk:"timezone"`
+	QuietHours types.Object `tfsdk:"quiet_hours"`
+}
+
+type contactQuietHoursModel struct {
+	Start         types.String `tfsdk:"start"`
+	End           types.String `tfsdk:"end"`
+	AllowCritical types.Bool   `tfsdk:"allow_critical"`
+}
+
+type contactAPIRequest struct {
+	Name       string                 `json:"name"`
+	Email      string                 `json:"email,omitempty"`
+	Phone      string                 `json:"phone,omitempty"`
+	Timezone   string                 `json:"timezone,omitempty"`
+	QuietHours *contactQuietHoursJSON `json:"quiet_hours"`
+}
+
+type contactAPIResponse struct {
+	ID         int64                  `json:"id"`
+	Name       string                 `json:"name"`
+	Email      string                 `json:"email"`
+	Phone      string                 `json:"phone"`
+	Timezone   string                 `json:"timezone"`
+	QuietHours *contactQuietHoursJSON `json:"quiet_hours"`
+}
+
+type contactQuietHoursJSON struct {
+	Start         string `json:"start"`
+	End           string `json:"end"`
+	AllowCritical bool   `json:"allow_critical"`
+}
+
+func (r *contactResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contact"
+}
+
+func (r *contactResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a notification contact: a person alerts are delivered to. Contacts are separate from login users (tinymon_user).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the contact.",
+				Required:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address alerts are sent to. At least one of email and phone is required.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringMatches(contactEmail, "an email address"),
+				},
+			},
+			"phone": schema.StringAttribute{
+				Description: "Phone number for SMS and voice alerts in E.164 format, e.g. +4915112345678.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringMatches(contactPhone, "a phone number in E.164 format like +4915112345678"),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA time zone of the contact, used for quiet hours. Defaults to the server's time zone.",
+				Optional:    true,
+				Validators: []validator.String{
+					timezone(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			// Attributes are Optional because the framework enforces Required
+			// attributes of a single nested block even when it is absent.
+			"quiet_hours": schema.SingleNestedBlock{
+				Description: "Daily period in the contact's time zone during which alerts are held back.",
+				Attributes: map[string]schema.Attribute{
+					"start": schema.StringAttribute{
+						Description: "Start of the quiet period as HH:MM. Required.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringMatches(clockTime, "a time of day like 22:00"),
+						},
+					},
+					"end": schema.StringAttribute{
+						Description: "End of the quiet period as HH:MM. May be earlier than start to span midnight. Required.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringMatches(clockTime, "a time of day like 07:00"),
+						},
+					},
+					"allow_critical": schema.BoolAttribute{
+						Description: "Still deliver critical alerts during quiet hours. Defaults to false.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *contactResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *contactResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config contactResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Email.IsNull() && config.Phone.IsNull() {
+		resp.Diagnostics.AddError("Missing contact details", "At least one of email and phone must be set.")
+	}
+
+	if !config.QuietHours.IsNull() && !config.QuietHours.IsUnknown() {
+		attrs := config.QuietHours.Attributes()
+		for _, name := range []string{"start", "end"} {
+			if value, ok := attrs[name]; ok && value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("quiet_hours").AtName(name), "Missing required argument",
+					fmt.Sprintf("The argument %q is required in quiet_hours.", name))
+			}
+		}
+	}
+}
+
+func (r *contactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan contactResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := contactToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result contactAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/contacts", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating contact", err)
+		return
+	}
+
+	mapContactResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *contactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state contactResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/contacts/%d", state.ID.ValueInt64())
+
+	var result contactAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading contact", err)
+		return
+	}
+
+	mapContactResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *contactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan contactResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := contactToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/contacts/%d", plan.ID.ValueInt64())
+
+	var result contactAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating contact", err)
+		return
+	}
+
+	mapContactResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *contactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state contactResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/contacts/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting contact", err)
+		return
+	}
+}
+
+func (r *contactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric contact ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// contactToAPI sends quiet_hours as null when the block is absent, which
+// clears quiet hours set earlier.
+func contactToAPI(ctx context.Context, plan *contactResourceModel) (contactAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := contactAPIRequest{
+		Name:     plan.Name.ValueString(),
+		Email:    plan.Email.ValueString(),
+		Phone:    plan.Phone.ValueString(),
+		Timezone: plan.Timezone.ValueString(),
+	}
+
+	if !plan.QuietHours.IsNull() {
+		var quietHours contactQuietHoursModel
+		diags.Append(plan.QuietHours.As(ctx, &quietHours, basetypes.ObjectAsOptions{})...)
+		body.QuietHours = &contactQuietHoursJSON{
+			Start:         quietHours.Start.ValueString(),
+			End:           quietHours.End.ValueString(),
+			AllowCritical: quietHours.AllowCritical.ValueBool(),
+		}
+	}
+
+	return body, diags
+}
+
+func mapContactResponseToState(apiResp *contactAPIResponse, state *contactResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.Email = stringOrNull(apiResp.Email)
+	state.Phone = stringOrNull(apiResp.Phone)
+	state.Timezone = stringOrNull(apiResp.Timezone)
+
+	if apiResp.QuietHours == nil {
+		state.QuietHours = types.ObjectNull(contactQuietHoursAttrTypes)
+		return
+	}
+
+	// allow_critical is optional without a default; keep it unset when the
+	// configuration left it out and the server reports false.
+	allowCritical := types.BoolValue(apiResp.QuietHours.AllowCritical)
+	if !apiResp.QuietHours.AllowCritical && !state.QuietHours.IsNull() && !state.QuietHours.IsUnknown() {
+		if value, ok := state.QuietHours.Attributes()["allow_critical"].(types.Bool); ok && value.IsNull() {
+			allowCritical = types.BoolNull()
+		}
+	}
+
+	state.QuietHours = types.ObjectValueMust(contactQuietHoursAttrTypes, map[string]attr.Value{
+		"start":          types.StringValue(apiResp.QuietHours.Start),
+		"end":            types.StringValue(apiResp.QuietHours.End),
+		"allow_critical": allowCritical,
+	})
+}
@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &dashboardResource{}
+	_ resource.ResourceWithImportState    = &dashboardResource{}
+	_ resource.ResourceWithValidateConfig = &dashboardResource{}
+)
+
+func NewDashboardResource() resource.Resource {
+	return &dashboardResource{}
+}
+
+type dashboardResource struct {
+	client *TinyMonClient
+}
+
+var dashboardWidgetAttrTypes = map[string]attr.Type{
+	"type":         types.StringType,
+	"title":        types.StringType,
+	"host_address": types.StringType,
+	"check_id":     types.Int64Type,
+	"topic":        types.StringType,
+}
+
+type dashboardResourceModel struct {
+	ID     types.Int64  `tfsdk:"id"`
+	Title  types.String `tfsdk:"title"`
+	Layout types.String `tfsdk:"layout"`
+	// Widgets is a list of dashboardWidgetModel. It is a types.List so
+	// ValidateConfig can handle a list that is unknown until apply.
+	Widgets types.List `tfsdk:"widgets"`
+}
+
+type dashboardWidgetModel struct {
+	Type        types.String `tfsdk:"type"`
+	Title       types.String `tfsdk:"title"`
+	HostAddress types.String `tfsdk:"host_address"`
+	CheckID     types.Int64  `tfsdk:"check_id"`
+	Topic       types.String `tfsdk:"topic"`
+}
+
+type dashboardAPIRequest struct {
+	Title   string               `json:"title"`
+	Layout  string               `json:"layout"`
+	Widgets []dashboardAPIWidget `json:"widgets"`
+}
+
+type dashboardAPIResponse struct {
+	ID      int64                `json:"id"`
+	Title   string               `json:"title"`
+	Layout  string               `json:"layout"`
+	Widgets []dashboardAPIWidget `json:"widgets"`
+}
+
+type dashboardAPIWidget struct {
+	Type        string `json:"type"`
+	Title       string `json:"title,omitempty"`
+	HostAddress string `json:"host_address,omitempty"`
+	CheckID     int64  `json:"check_id,omitempty"`
+	Topic       string `json:"topic,omitempty"`
+}
+
+func (r *dashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom dashboard in TinyMon.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Dashboard title.",
+				Required:    true,
+			},
+			"layout": schema.StringAttribute{
+				Description: "Widget arrangement (grid or list).",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("grid"),
+				Validators: []validator.String{
+					stringOneOf("grid", "list"),
+				},
+			},
+			"widgets": schema.ListNestedAttribute{
+				Description: "Widgets in display order.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Widget type (status, uptime, latency, list).",
+							Required:    true,
+							Validators: []validator.String{
+								stringOneOf("status", "uptime", "latency", "list"),
+							},
+						},
+						"title": schema.StringAttribute{
+							Description: "Widget title.",
+							Optional:    true,
+						},
+						"host_address": schema.StringAttribute{
+							Description: "Host shown by the widget.",
+							Optional:    true,
+						},
+						"check_id": schema.Int64Attribute{
+							Description: "Check shown by the widget.",
+							Optional:    true,
+						},
+						"topic": schema.StringAttribute{
+							Description: "Topic shown by the widget.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dashboardResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *dashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dashboardResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Widgets.IsNull() || config.Widgets.IsUnknown() {
+		return
+	}
+	for i, elem := range config.Widgets.Elements() {
+		widget, ok := elem.(types.Object)
+		if !ok || widget.IsUnknown() {
+			continue
+		}
+		attrs := widget.Attributes()
+		if attrs["host_address"].IsNull() && attrs["check_id"].IsNull() && attrs["topic"].IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("widgets").AtListIndex(i),
+				"Missing widget target",
+				"Each widget must reference at least one of host_address, check_id or topic.")
+		}
+	}
+}
+
+func (r *dashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dashboardResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	widgets, diags := dashboardWidgetsToAPI(ctx, plan.Widgets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := dashboardAPIRequest{
+		Title:   plan.Title.ValueString(),
+		Layout:  plan.Layout.ValueString(),
+		Widgets: widgets,
+	}
+
+	var result dashboardAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/dashboards", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating dashboard", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapDashboardResponseToState(ctx, &result, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dashboardResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/dashboards/%d", state.ID.ValueInt64())
+
+	var result dashboardAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading dashboard", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapDashboardResponseToState(ctx, &result, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dashboardResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	widgets, diags := dashboardWidgetsToAPI(ctx, plan.Widgets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := dashboardAPIRequest{
+		Title:   plan.Title.ValueString(),
+		Layout:  plan.Layout.ValueString(),
+		Widgets: widgets,
+	}
+
+	apiPath := fmt.Sprintf("/api/push/dashboards/%d", plan.ID.ValueInt64())
+
+	var result dashboardAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating dashboard", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapDashboardResponseToState(ctx, &result, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dashboardResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/dashboards/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting dashboard", err)
+		return
+	}
+}
+
+func (r *dashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric dashboard ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func dashboardWidgetsToAPI(ctx context.Context, list types.List) ([]dashboardAPIWidget, diag.Diagnostics) {
+	var widgets []dashboardWidgetModel
+	diags := list.ElementsAs(ctx, &widgets, false)
+
+	result := make([]dashboardAPIWidget, 0, len(widgets))
+	for _, widget := range widgets {
+		result = append(result, dashboardAPIWidget{
+			Type:        widget.Type.ValueString(),
+			Title:       widget.Title.ValueString(),
+			HostAddress: widget.HostAddress.ValueString(),
+			CheckID:     widget.CheckID.ValueInt64(),
+			Topic:       widget.Topic.ValueString(),
+		})
+	}
+	return result, diags
+}
+
+func mapDashboardResponseToState(ctx context.Context, apiResp *dashboardAPIResponse, state *dashboardResourceModel) diag.Diagnostics {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Title = types.StringValue(apiResp.Title)
+	state.Layout = types.StringValue(apiResp.Layout)
+
+	widgets := make([]dashboardWidgetModel, 0, len(apiResp.Widgets))
+	for _, widget := range apiResp.Widgets {
+		widgets = append(widgets, dashboardWidgetModel{
+			Type:        types.StringValue(widget.Type),
+			Title:       stringOrNull(widget.Title),
+			HostAddress: stringOrNull(widget.HostAddress),
+			CheckID:     int64OrNull(widget.CheckID),
+			Topic:       stringOrNull(widget.Topic),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dashboardWidgetAttrTypes}, widgets)
+	state.Widgets = list
+	return diags
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &downtimeResource{}
+	_ resource.ResourceWithImportState    = &downtimeResource{}
+	_ resource.ResourceWithValidateConfig = &downtimeResource{}
+)
+
+func NewDowntimeResource() resource.Resource {
+	return &downtimeResource{}
+}
+
+type downtimeResource struct {
+	client *TinyMonClient
+}
+
+type downtimeResourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Comment       types.String `tfsdk:"comment"`
+	StartsAt      types.String `tfsdk:"starts_at"`
+	EndsAt        types.String `tfsdk:"ends_at"`
+	HostAddresses types.Set    `tfsdk:"host_addresses"`
+	CheckIDs      types.Set    `tfsdk:"check_ids"`
+	Active        types.Bool   `tfsdk:"active"`
+}
+
+type downtimeAPIRequest struct {
+	Comment       string   `json:"comment,omitempty"`
+	StartsAt      string   `json:"starts_at"`
+	EndsAt        string   `json:"ends_at"`
+	HostAddresses []string `json:"host_addresses"`
+	CheckIDs      []int64  `json:"check_ids"`
+}
+
+type downtimeAPIResponse struct {
+	ID            int64    `json:"id"`
+	Comment       string   `json:"comment"`
+	StartsAt      string   `json:"starts_at"`
+	EndsAt        string   `json:"ends_at"`
+	HostAddresses []string `json:"host_addresses"`
+	CheckIDs      []int64  `json:"check_ids"`
+	Active        bool     `json:"active"`
+}
+
+func (r *downtimeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_downtime"
+}
+
+func (r *downtimeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Suppresses alerts for hosts or checks during a single fixed window. The server removes the downtime once it has ended.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "Reason for the downtime, shown in the UI and the event log.",
+				Optional:    true,
+			},
+			"starts_at": schema.StringAttribute{
+				Description: "Start of the downtime (RFC 3339).",
+				Required:    true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"ends_at": schema.StringAttribute{
+				Description: "End of the downtime (RFC 3339). Must be after starts_at.",
+				Required:    true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"host_addresses": schema.SetAttribute{
+				Description: "Hosts whose checks are suppressed. At least one of host_addresses and check_ids is required.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"check_ids": schema.SetAttribute{
+				Description: "Individual checks that are suppressed. At least one of host_addresses and check_ids is required.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the downtime is currently in effect. Updated on refresh.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *downtimeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *downtimeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config downtimeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.HostAddresses.IsNull() && config.CheckIDs.IsNull() {
+		resp.Diagnostics.AddError("Missing downtime target",
+			"At least one of host_addresses and check_ids must be set.")
+	}
+
+	if config.StartsAt.IsNull() || config.StartsAt.IsUnknown() || config.EndsAt.IsNull() || config.EndsAt.IsUnknown() {
+		return
+	}
+	startsAt, err1 := time.Parse(time.RFC3339, config.StartsAt.ValueString())
+	endsAt, err2 := time.Parse(time.RFC3339, config.EndsAt.ValueString())
+	if err1 == nil && err2 == nil && !endsAt.After(startsAt) {
+		resp.Diagnostics.AddAttributeError(path.Root("ends_at"), "Invalid downtime window",
+			"ends_at must be after starts_at.")
+	}
+}
+
+func (r *downtimeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan downtimeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := downtimeToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result downtimeAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/downtimes", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating downtime", err)
+		return
+	}
+
+	mapDowntimeResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *downtimeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state downtimeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/downtimes/%d", state.ID.ValueInt64())
+
+	var result downtimeAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			// The server removes downtimes once they have ended. Keep an
+			// expired one in state so it isn't recreated on the next apply.
+			if downtimeExpired(state.EndsAt) {
+				state.Active = types.BoolValue(false)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+				return
+			}
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading downtime", err)
+		return
+	}
+
+	mapDowntimeResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *downtimeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan downtimeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := downtimeToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/downtimes/%d", plan.ID.ValueInt64())
+
+	var result downtimeAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating downtime", err)
+		return
+	}
+
+	mapDowntimeResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *downtimeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state downtimeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/downtimes/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting downtime", err)
+		return
+	}
+}
+
+func (r *downtimeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric downtime ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// downtimeExpired reports whether the end of a downtime lies in the past.
+func downtimeExpired(endsAt types.String) bool {
+	end, err := time.Parse(time.RFC3339, endsAt.ValueString())
+	return err == nil && !end.After(time.Now())
+}
+
+// downtimeToAPI always sends the targets, so unset ones clear targets set
+// earlier.
+func downtimeToAPI(ctx context.Context, plan *downtimeResourceModel) (downtimeAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hostAddresses := []string{}
+	if !plan.HostAddresses.IsNull() {
+		diags.Append(plan.HostAddresses.ElementsAs(ctx, &hostAddresses, false)...)
+	}
+
+	body := downtimeAPIRequest{
+		Comment:       plan.Comment.ValueString(),
+		StartsAt:      plan.StartsAt.ValueString(),
+		EndsAt:        plan.EndsAt.ValueString(),
+		HostAddresses: hostAddresses,
+		CheckIDs:      idSetFromPlan(ctx, plan.CheckIDs, &diags),
+	}
+	return body, diags
+}
+
+func mapDowntimeResponseToState(apiResp *downtimeAPIResponse, state *downtimeResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Comment = stringOrNull(apiResp.Comment)
+	state.StartsAt = timestampValue(apiResp.StartsAt, state.StartsAt)
+	state.EndsAt = timestampValue(apiResp.EndsAt, state.EndsAt)
+	state.HostAddresses = stringSetToState(apiResp.HostAddresses, state.HostAddresses)
+	state.CheckIDs = idSetToState(apiResp.CheckIDs, state.CheckIDs)
+	state.Active = types.BoolValue(apiResp.Active)
+}
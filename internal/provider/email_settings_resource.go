@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &emailSettingsResource{}
+	_ resource.ResourceWithImportState = &emailSettingsResource{}
+)
+
+// emailSettingsID is the fixed ID of the singleton; the server has exactly
+// one set of email settings.
+const emailSettingsID = "email"
+
+func NewEmailSettingsResource() resource.Resource {
+	return &emailSettingsResource{}
+}
+
+type emailSettingsResource struct {
+	client *TinyMonClient
+}
+
+type emailSettingsResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	SMTPHost     types.String `tfsdk:"smtp_host"`
+	SMTPPort     types.Int64  `tfsdk:"smtp_port"`
+	SMTPUsername types.String `tfsdk:"smtp_username"`
+	SMTPPassword types.String `tfsdk:"smtp_password"`
+	Encryption   types.String `tfsdk:"encryption"`
+	FromAddress  types.String `tfsdk:"from_address"`
+	FromName     types.String `tfsdk:"from_name"`
+}
+
+type emailSettingsAPIRequest struct {
+	SMTPHost     string `json:"smtp_host"`
+	SMTPPort     int64  `json:"smtp_port"`
+	SMTPUsername string `json:"smtp_username"`
+	SMTPPassword string `json:"smtp_password"`
+	Encryption   string `json:"encryption"`
+	FromAddress  string `json:"from_address"`
+	FromName     string `json:"from_name"`
+}
+
+// emailSettingsAPIResponse does not include the SMTP password; it is
+// write-only on the server and kept from the configuration.
+type emailSettingsAPIResponse struct {
+	SMTPHost     string `json:"smtp_host"`
+	SMTPPort     int64  `json:"smtp_port"`
+	SMTPUsername string `json:"smtp_username"`
+	Encryption   string `json:"encryption"`
+	FromAddress  string `json:"from_address"`
+	FromName     string `json:"from_name"`
+}
+
+func (r *emailSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_settings"
+}
+
+func (r *emailSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the server's outbound email (SMTP) settings. There is one instance per server; destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"smtp_host": schema.StringAttribute{
+				Description: "SMTP server hostname.",
+				Required:    true,
+			},
+			"smtp_port": schema.Int64Attribute{
+				Description: "SMTP server port.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(587),
+				Validators: []validator.Int64{
+					int64Between(1, 65535),
+				},
+			},
+			"smtp_username": schema.StringAttribute{
+				Description: "SMTP login. Leave unset for servers without authentication.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"smtp_password": schema.StringAttribute{
+				Description: "SMTP password. Not returned by the server, so changes made outside Terraform are not detected.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"encryption": schema.StringAttribute{
+				Description: "Transport security (none, starttls, tls).",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("starttls"),
+				Validators: []validator.String{
+					stringOneOf("none", "starttls", "tls"),
+				},
+			},
+			"from_address": schema.StringAttribute{
+				Description: "Sender address of alert emails.",
+				Required:    true,
+			},
+			"from_name": schema.StringAttribute{
+				Description: "Sender display name.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *emailSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// Create adopts the existing settings; the singleton always exists on the
+// server, so creating it is the same as updating it.
+func (r *emailSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan emailSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result emailSettingsAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", "/api/push/settings/email", emailSettingsToAPI(&plan), &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating email settings", err)
+		return
+	}
+
+	mapEmailSettingsResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *emailSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state emailSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result emailSettingsAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", "/api/push/settings/email", nil, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading email settings", err)
+		return
+	}
+
+	mapEmailSettingsResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *emailSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan emailSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result emailSettingsAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", "/api/push/settings/email", emailSettingsToAPI(&plan), &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating email settings", err)
+		return
+	}
+
+	mapEmailSettingsResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only forgets the settings. Clearing them would silently stop alert
+// emails, which is never what removing the resource from a config means.
+func (r *emailSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning("Email settings left unchanged",
+		"tinymon_email_settings was removed from state. The settings on the server were not modified.")
+}
+
+func (r *emailSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != emailSettingsID {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("Import ID must be %q; the server has a single set of email settings.", emailSettingsID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), emailSettingsID)...)
+}
+
+func emailSettingsToAPI(plan *emailSettingsResourceModel) emailSettingsAPIRequest {
+	return emailSettingsAPIRequest{
+		SMTPHost:     plan.SMTPHost.ValueString(),
+		SMTPPort:     plan.SMTPPort.ValueInt64(),
+		SMTPUsername: plan.SMTPUsername.ValueString(),
+		SMTPPassword: plan.SMTPPassword.ValueString(),
+		Encryption:   plan.Encryption.ValueString(),
+		FromAddress:  plan.FromAddress.ValueString(),
+		FromName:     plan.FromName.ValueString(),
+	}
+}
+
+func mapEmailSettingsResponseToState(apiResp *emailSettingsAPIResponse, state *emailSettingsResourceModel) {
+	state.ID = types.StringValue(emailSettingsID)
+	state.SMTPHost = types.StringValue(apiResp.SMTPHost)
+	state.SMTPPort = types.Int64Value(apiResp.SMTPPort)
+	state.SMTPUsername = stringOrNull(apiResp.SMTPUsername)
+	state.Encryption = types.StringValue(apiResp.Encryption)
+	state.FromAddress = types.StringValue(apiResp.FromAddress)
+	state.FromName = stringOrNull(apiResp.FromName)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+// isNotFound reports whether err is an API 404 response.
+func isNotFound(err error) bool {
+	return tinymon.IsNotFound(err)
+}
+
+// addAPIErrorDiagnostics reports err under summary as a general error. Field
+// errors returned by the server are listed in the detail; use
+// addAPIFieldErrorDiagnostics to attach them to attributes.
+func addAPIErrorDiagnostics(diags *diag.Diagnostics, summary string, err error) {
+	addAPIFieldErrorDiagnostics(diags, summary, err, nil)
+}
+
+// addAPIFieldErrorDiagnostics reports err under summary. Field errors for
+// fields in the lookup are attached to the mapped attribute so Terraform
+// points at the offending line. The general error always carries the
+// server's top-level message, followed by field errors without an attribute.
+func addAPIFieldErrorDiagnostics(diags *diag.Diagnostics, summary string, err error, fields map[string]path.Path) {
+	var apiErr *tinymon.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.FieldErrors) == 0 {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	detail := apiErr.Error()
+	for _, fieldErr := range apiErr.FieldErrors {
+		if p, ok := fields[fieldErr.Field]; ok {
+			diags.AddAttributeError(p, summary, fieldErr.Message)
+			continue
+		}
+		if fieldErr.Field == "" {
+			detail += "\n" + fieldErr.Message
+		} else {
+			detail += fmt.Sprintf("\n%s: %s", fieldErr.Field, fieldErr.Message)
+		}
+	}
+	diags.AddError(summary, detail)
+}
+
+// apiFieldPaths builds the lookup for addAPIFieldErrorDiagnostics from API
+// field names that match attribute names, e.g. "config" or
+// "warning_threshold.latency_ms".
+func apiFieldPaths(fields ...string) map[string]path.Path {
+	paths := make(map[string]path.Path, len(fields))
+	for _, field := range fields {
+		paths[field] = fieldPath(field)
+	}
+	return paths
+}
+
+// fieldPath converts a dotted API field name such as "config" or
+// "thresholds.warning" into an attribute path.
+func fieldPath(field string) path.Path {
+	parts := strings.Split(field, ".")
+	p := path.Root(parts[0])
+	for _, part := range parts[1:] {
+		p = p.AtName(part)
+	}
+	return p
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                   = &eventsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &eventsDataSource{}
+)
+
+// eventTypes are the kinds of entries in the event log.
+var eventTypes = []string{"state_change", "config_change", "acknowledgement", "maintenance"}
+
+func NewEventsDataSource() datasource.DataSource {
+	return &eventsDataSource{}
+}
+
+type eventsDataSource struct {
+	client *TinyMonClient
+}
+
+type eventsDataSourceModel struct {
+	Since       types.String                 `tfsdk:"since"`
+	Until       types.String                 `tfsdk:"until"`
+	HostAddress types.String                 `tfsdk:"host_address"`
+	Types       types.Set                    `tfsdk:"types"`
+	Limit       types.Int64                  `tfsdk:"limit"`
+	Events      []eventsDataSourceEventModel `tfsdk:"events"`
+}
+
+type eventsDataSourceEventModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Time        types.String `tfsdk:"time"`
+	Type        types.String `tfsdk:"type"`
+	HostAddress types.String `tfsdk:"host_address"`
+	CheckID     types.Int64  `tfsdk:"check_id"`
+	Actor       types.String `tfsdk:"actor"`
+	Message     types.String `tfsdk:"message"`
+}
+
+type eventAPIResponse struct {
+	ID          int64  `json:"id"`
+	Time        string `json:"time"`
+	Type        string `json:"type"`
+	HostAddress string `json:"host_address"`
+	CheckID     int64  `json:"check_id"`
+	Actor       string `json:"actor"`
+	Message     string `json:"message"`
+}
+
+func (d *eventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_events"
+}
+
+func (d *eventsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns entries of the event log: status changes, configuration changes, acknowledgements and maintenance.",
+		Attributes: map[string]schema.Attribute{
+			"since": schema.StringAttribute{
+				Description: "Only return events at or after this time (RFC 3339). Defaults to 24 hours ago.",
+				Optional:    true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"until": schema.StringAttribute{
+				Description: "Only return events before this time (RFC 3339). Defaults to now.",
+				Optional:    true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"host_address": schema.StringAttribute{
+				Description: "Only return events of the host with this address.",
+				Optional:    true,
+			},
+			"types": schema.SetAttribute{
+				Description: "Only return events of these types: state_change, config_change, acknowledgement, maintenance.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setValuesOneOf(eventTypes...),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of events returned, newest first (1-1000). Defaults to 100.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 1000),
+				},
+			},
+			"events": schema.ListNestedAttribute{
+				Description: "Matching events, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"time": schema.StringAttribute{
+							Description: "When the event happened, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"host_address": schema.StringAttribute{
+							Description: "Affected host. Null for events not tied to a host.",
+							Computed:    true,
+						},
+						"check_id": schema.Int64Attribute{
+							Description: "Affected check. Null for events not tied to a check.",
+							Computed:    true,
+						},
+						"actor": schema.StringAttribute{
+							Description: "User or API key that caused the event. Null for events raised by the server itself.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *eventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *eventsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config eventsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Since.IsNull() || config.Since.IsUnknown() || config.Until.IsNull() || config.Until.IsUnknown() {
+		return
+	}
+	// Malformed timestamps are reported by the attribute validators.
+	since, err1 := time.Parse(time.RFC3339, config.Since.ValueString())
+	until, err2 := time.Parse(time.RFC3339, config.Until.ValueString())
+	if err1 == nil && err2 == nil && !until.After(since) {
+		resp.Diagnostics.AddAttributeError(path.Root("until"), "Invalid time range",
+			"until must be after since.")
+	}
+}
+
+func (d *eventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state eventsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if !state.Since.IsNull() {
+		query.Set("since", state.Since.ValueString())
+	}
+	if !state.Until.IsNull() {
+		query.Set("until", state.Until.ValueString())
+	}
+	if !state.HostAddress.IsNull() {
+		query.Set("host_address", state.HostAddress.ValueString())
+	}
+	if !state.Types.IsNull() {
+		var selected []string
+		resp.Diagnostics.Append(state.Types.ElementsAs(ctx, &selected, false)...)
+		for _, eventType := range selected {
+			query.Add("type", eventType)
+		}
+	}
+	if !state.Limit.IsNull() {
+		query.Set("limit", strconv.FormatInt(state.Limit.ValueInt64(), 10))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result []eventAPIResponse
+	if err := d.client.DoJSON(ctx, "GET", "/api/push/events?"+query.Encode(), nil, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error listing events", err)
+		return
+	}
+
+	state.Events = make([]eventsDataSourceEventModel, 0, len(result))
+	for _, event := range result {
+		state.Events = append(state.Events, eventsDataSourceEventModel{
+			ID:          types.Int64Value(event.ID),
+			Time:        types.StringValue(event.Time),
+			Type:        types.StringValue(event.Type),
+			HostAddress: stringOrNull(event.HostAddress),
+			CheckID:     int64OrNull(event.CheckID),
+			Actor:       stringOrNull(event.Actor),
+			Message:     types.StringValue(event.Message),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
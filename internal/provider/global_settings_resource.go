@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &globalSettingsResource{}
+	_ resource.ResourceWithImportState = &globalSettingsResource{}
+)
+
+// globalSettingsID is the fixed ID of the singleton.
+const globalSettingsID = "global"
+
+func NewGlobalSettingsResource() resource.Resource {
+	return &globalSettingsResource{}
+}
+
+type globalSettingsResource struct {
+	client *TinyMonClient
+}
+
+type globalSettingsResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	DefaultIntervalSeconds types.Int64  `tfsdk:"default_interval_seconds"`
+	RetentionDays          types.Int64  `tfsdk:"retention_days"`
+	UITitle                types.String `tfsdk:"ui_title"`
+	Timezone               types.String `tfsdk:"timezone"`
+}
+
+// globalSettingsAPIRequest leaves out settings that are not configured, so
+// the server keeps its current values for them.
+type globalSettingsAPIRequest struct {
+	DefaultIntervalSeconds *int64  `json:"default_interval_seconds,omitempty"`
+	RetentionDays          *int64  `json:"retention_days,omitempty"`
+	UITitle                *string `json:"ui_title,omitempty"`
+	Timezone               *string `json:"timezone,omitempty"`
+}
+
+type globalSettingsAPIResponse struct {
+	DefaultIntervalSeconds int64  `json:"default_interval_seconds"`
+	RetentionDays          int64  `json:"retention_days"`
+	UITitle                string `json:"ui_title"`
+	Timezone               string `json:"timezone"`
+}
+
+func (r *globalSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_settings"
+}
+
+func (r *globalSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages instance-wide TinyMon settings. There is one instance per server; unset attributes keep the server's value and destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_interval_seconds": schema.Int64Attribute{
+				Description: "Check interval used when a check does not set interval_seconds.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64Between(10, 86400),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"retention_days": schema.Int64Attribute{
+				Description: "Days check results are kept before they are purged.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 3650),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ui_title": schema.StringAttribute{
+				Description: "Title shown in the web UI and browser tab.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA time zone used for display and schedules, e.g. Europe/Berlin.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					timezone(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *globalSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// Create adopts the existing settings and applies the configured ones.
+func (r *globalSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan globalSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result globalSettingsAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", "/api/push/settings/global", globalSettingsToAPI(&plan), &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating global settings", err)
+		return
+	}
+
+	mapGlobalSettingsResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *globalSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state globalSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result globalSettingsAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", "/api/push/settings/global", nil, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading global settings", err)
+		return
+	}
+
+	mapGlobalSettingsResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *globalSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan globalSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result globalSettingsAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", "/api/push/settings/global", globalSettingsToAPI(&plan), &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating global settings", err)
+		return
+	}
+
+	mapGlobalSettingsResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only forgets the settings; the server has no "unset" for them.
+func (r *globalSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning("Global settings left unchanged",
+		"tinymon_global_settings was removed from state. The settings on the server were not modified.")
+}
+
+func (r *globalSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != globalSettingsID {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("Import ID must be %q; the server has a single set of global settings.", globalSettingsID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), globalSettingsID)...)
+}
+
+func globalSettingsToAPI(plan *globalSettingsResourceModel) globalSettingsAPIRequest {
+	var body globalSettingsAPIRequest
+	if !plan.DefaultIntervalSeconds.IsNull() && !plan.DefaultIntervalSeconds.IsUnknown() {
+		body.DefaultIntervalSeconds = plan.DefaultIntervalSeconds.ValueInt64Pointer()
+	}
+	if !plan.RetentionDays.IsNull() && !plan.RetentionDays.IsUnknown() {
+		body.RetentionDays = plan.RetentionDays.ValueInt64Pointer()
+	}
+	if !plan.UITitle.IsNull() && !plan.UITitle.IsUnknown() {
+		body.UITitle = plan.UITitle.ValueStringPointer()
+	}
+	if !plan.Timezone.IsNull() && !plan.Timezone.IsUnknown() {
+		body.Timezone = plan.Timezone.ValueStringPointer()
+	}
+	return body
+}
+
+func mapGlobalSettingsResponseToState(apiResp *globalSettingsAPIResponse, state *globalSettingsResourceModel) {
+	state.ID = types.StringValue(globalSettingsID)
+	state.DefaultIntervalSeconds = types.Int64Value(apiResp.DefaultIntervalSeconds)
+	state.RetentionDays = types.Int64Value(apiResp.RetentionDays)
+	state.UITitle = types.StringValue(apiResp.UITitle)
+	state.Timezone = types.StringValue(apiResp.Timezone)
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &heartbeatResource{}
+	_ resource.ResourceWithImportState = &heartbeatResource{}
+)
+
+func NewHeartbeatResource() resource.Resource {
+	return &heartbeatResource{}
+}
+
+type heartbeatResource struct {
+	client *TinyMonClient
+}
+
+type heartbeatResourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	HostAddress        types.String `tfsdk:"host_address"`
+	Name               types.String `tfsdk:"name"`
+	IntervalSeconds    types.Int64  `tfsdk:"interval_seconds"`
+	GracePeriodSeconds types.Int64  `tfsdk:"grace_period_seconds"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Token              types.String `tfsdk:"token"`
+	PingURL            types.String `tfsdk:"ping_url"`
+}
+
+type heartbeatAPIRequest struct {
+	HostAddress        string `json:"host_address"`
+	Name               string `json:"name"`
+	IntervalSeconds    int64  `json:"interval_seconds"`
+	GracePeriodSeconds int64  `json:"grace_period_seconds"`
+	Enabled            int    `json:"enabled"`
+}
+
+type heartbeatAPIResponse struct {
+	ID                 int64  `json:"id"`
+	HostAddress        string `json:"host_address"`
+	Name               string `json:"name"`
+	IntervalSeconds    int64  `json:"interval_seconds"`
+	GracePeriodSeconds int64  `json:"grace_period_seconds"`
+	Enabled            int    `json:"enabled"`
+	Token              string `json:"token"`
+	PingURL            string `json:"ping_url"`
+}
+
+type heartbeatDeleteRequest struct {
+	HostAddress string `json:"host_address"`
+	Name        string `json:"name"`
+}
+
+func (r *heartbeatResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_heartbeat"
+}
+
+func (r *heartbeatResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a heartbeat (passive) check in TinyMon. The monitored system pings the generated URL; the check fails when no ping arrives within the interval plus grace period.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"host_address": schema.StringAttribute{
+				Description: "Address of the host. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Heartbeat name, unique per host. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interval_seconds": schema.Int64Attribute{
+				Description: "Expected time between pings in seconds.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(300),
+			},
+			"grace_period_seconds": schema.Int64Attribute{
+				Description: "Extra time after a missed ping before the heartbeat is marked as failed.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(60),
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"token": schema.StringAttribute{
+				Description: "Generated ping token.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ping_url": schema.StringAttribute{
+				Description: "URL the monitored system must request to report a heartbeat.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *heartbeatResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *heartbeatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan heartbeatResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+
+	body := heartbeatAPIRequest{
+		HostAddress:        plan.HostAddress.ValueString(),
+		Name:               plan.Name.ValueString(),
+		IntervalSeconds:    plan.IntervalSeconds.ValueInt64(),
+		GracePeriodSeconds: plan.GracePeriodSeconds.ValueInt64(),
+		Enabled:            enabled,
+	}
+
+	var result heartbeatAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/heartbeats", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating heartbeat", err)
+		return
+	}
+
+	mapHeartbeatResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *heartbeatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state heartbeatResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queryPath := fmt.Sprintf("/api/push/heartbeats?host_address=%s&name=%s",
+		url.QueryEscape(state.HostAddress.ValueString()),
+		url.QueryEscape(state.Name.ValueString()),
+	)
+
+	var result heartbeatAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", queryPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading heartbeat", err)
+		return
+	}
+
+	mapHeartbeatResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *heartbeatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan heartbeatResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+
+	body := heartbeatAPIRequest{
+		HostAddress:        plan.HostAddress.ValueString(),
+		Name:               plan.Name.ValueString(),
+		IntervalSeconds:    plan.IntervalSeconds.ValueInt64(),
+		GracePeriodSeconds: plan.GracePeriodSeconds.ValueInt64(),
+		Enabled:            enabled,
+	}
+
+	var result heartbeatAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/heartbeats", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating heartbeat", err)
+		return
+	}
+
+	mapHeartbeatResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *heartbeatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state heartbeatResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := heartbeatDeleteRequest{
+		HostAddress: state.HostAddress.ValueString(),
+		Name:        state.Name.ValueString(),
+	}
+
+	if err := r.client.DoJSON(ctx, "DELETE", "/api/push/heartbeats", body, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting heartbeat", err)
+		return
+	}
+}
+
+func (r *heartbeatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostAddress, name, ok := strings.Cut(req.ID, "/")
+	if !ok || hostAddress == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid import ID",
+			"Import ID must be: host_address/name")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host_address"), hostAddress)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func mapHeartbeatResponseToState(apiResp *heartbeatAPIResponse, state *heartbeatResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.HostAddress = types.StringValue(apiResp.HostAddress)
+	state.Name = types.StringValue(apiResp.Name)
+	state.IntervalSeconds = types.Int64Value(apiResp.IntervalSeconds)
+	state.GracePeriodSeconds = types.Int64Value(apiResp.GracePeriodSeconds)
+	state.Enabled = types.BoolValue(apiResp.Enabled != 0)
+	state.Token = types.StringValue(apiResp.Token)
+	state.PingURL = types.StringValue(apiResp.PingURL)
+}
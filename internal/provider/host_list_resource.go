@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+var (
+	_ list.ListResource              = &hostListResource{}
+	_ list.ListResourceWithConfigure = &hostListResource{}
+)
+
+func NewHostListResource() list.ListResource {
+	return &hostListResource{}
+}
+
+// hostListResource enumerates hosts for terraform query and config
+// generation. Results carry the tinymon_host identity.
+type hostListResource struct {
+	client *TinyMonClient
+}
+
+type hostListResourceModel struct {
+	Topic types.String `tfsdk:"topic"`
+	Tags  types.Map    `tfsdk:"tags"`
+}
+
+func (r *hostListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host"
+}
+
+func (r *hostListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists TinyMon hosts.",
+		Attributes: map[string]schema.Attribute{
+			"topic": schema.StringAttribute{
+				Description: "Only list hosts in this topic.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Only list hosts carrying all of these tags.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *hostListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *hostListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config hostListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tags := map[string]string{}
+	if !config.Tags.IsNull() {
+		diags.Append(config.Tags.ElementsAs(ctx, &tags, false)...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	hosts, err := r.client.ListHosts(ctx, tinymon.ListHostsOptions{Topic: config.Topic.ValueString(), Tags: tags})
+	if err != nil {
+		addAPIErrorDiagnostics(&diags, "Error listing hosts", err)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i := range hosts {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			host := &hosts[i]
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = host.Address
+			if host.Name != "" {
+				result.DisplayName = fmt.Sprintf("%s (%s)", host.Name, host.Address)
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, hostResourceIdentityModel{Address: types.StringValue(host.Address)})...)
+			if req.IncludeResource {
+				var state hostResourceModel
+				mapHostResponseToState(host, &state)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
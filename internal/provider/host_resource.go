@@ -3,22 +3,36 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/url"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
 )
 
 var (
-	_ resource.Resource                = &hostResource{}
-	_ resource.ResourceWithImportState = &hostResource{}
+	_ resource.Resource                   = &hostResource{}
+	_ resource.ResourceWithImportState    = &hostResource{}
+	_ resource.ResourceWithIdentity       = &hostResource{}
+	_ resource.ResourceWithValidateConfig = &hostResource{}
+)
+
+// hostAPIFields maps host request fields to attributes for server validation
+// errors.
+var hostAPIFields = apiFieldPaths(
+	"address", "name", "description", "topic", "parent_address", "enabled",
+	"tags", "tag_ids",
 )
 
 func NewHostResource() resource.Resource {
@@ -30,33 +44,26 @@ type hostResource struct {
 }
 
 type hostResourceModel struct {
-	ID          types.Int64  `tfsdk:"id"`
-	Address     types.String `tfsdk:"address"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Topic       types.String `tfsdk:"topic"`
-	Enabled     types.Bool   `tfsdk:"enabled"`
-}
+	ID            types.Int64  `tfsdk:"id"`
+	Address       types.String `tfsdk:"address"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Topic         types.String `tfsdk:"topic"`
+	ParentAddress types.String `tfsdk:"parent_address"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Tags          types.Map    `tfsdk:"tags"`
+	TagIDs        types.Set    `tfsdk:"tag_ids"`
+	OnConflict    types.String `tfsdk:"on_conflict"`
+	Organization  types.String `tfsdk:"organization"`
 
-type hostAPIRequest struct {
-	Address     string `json:"address"`
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description"`
-	Topic       string `json:"topic"`
-	Enabled     int    `json:"enabled"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	Timeouts           types.Object `tfsdk:"timeouts"`
 }
 
-type hostAPIResponse struct {
-	ID          int64  `json:"id"`
-	Name        string `json:"name"`
-	Address     string `json:"address"`
-	Description string `json:"description"`
-	Topic       string `json:"topic"`
-	Enabled     int    `json:"enabled"`
-}
-
-type hostDeleteRequest struct {
-	Address string `json:"address"`
+// hostResourceIdentityModel is the resource identity of a host. The address
+// is the host's natural key and cannot change without replacement.
+type hostResourceIdentityModel struct {
+	Address types.String `tfsdk:"address"`
 }
 
 func (r *hostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -96,11 +103,45 @@ func (r *hostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 				Default:     stringdefault.StaticString(""),
 			},
+			"parent_address": schema.StringAttribute{
+				Description: "Address of the upstream host (router, hypervisor, ...). Alerts for this host are suppressed while the parent is down.",
+				Optional:    true,
+			},
 			"enabled": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(true),
 			},
+			"tags": schema.MapAttribute{
+				Description: "Key/value labels, e.g. env = \"prod\".",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+			},
+			"tag_ids":      tagIDsSchema(),
+			"on_conflict":  onConflictSchema(),
+			"organization": organizationSchema(),
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Prevent the host from being destroyed or replaced. Set to false and apply before destroying it.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *hostResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"address": identityschema.StringAttribute{
+				Description:       "Host address.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -118,6 +159,20 @@ func (r *hostResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = client
 }
 
+func (r *hostResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config hostResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ParentAddress.IsNull() && !config.ParentAddress.IsUnknown() && !config.Address.IsUnknown() &&
+		config.ParentAddress.ValueString() == config.Address.ValueString() {
+		resp.Diagnostics.AddAttributeError(path.Root("parent_address"), "Invalid parent",
+			"A host cannot be its own parent.")
+	}
+}
+
 func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan hostResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -126,27 +181,78 @@ func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "create")
+	defer cancel()
+	ctx = withOrganization(ctx, plan.Organization)
+
 	enabled := 1
 	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
 		enabled = 0
 	}
 
-	body := hostAPIRequest{
-		Address:     plan.Address.ValueString(),
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
-		Topic:       plan.Topic.ValueString(),
-		Enabled:     enabled,
+	tags := map[string]string{}
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	tagIDs := idSetFromPlan(ctx, plan.TagIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := tinymon.HostRequest{
+		Address:       plan.Address.ValueString(),
+		Name:          plan.Name.ValueString(),
+		Description:   plan.Description.ValueString(),
+		Topic:         plan.Topic.ValueString(),
+		ParentAddress: plan.ParentAddress.ValueString(),
+		Enabled:       enabled,
+		Tags:          tags,
+		TagIDs:        tagIDs,
 	}
 
-	var result hostAPIResponse
-	if err := r.client.DoJSON("POST", "/api/push/hosts", body, &result); err != nil {
-		resp.Diagnostics.AddError("Error creating host", err.Error())
+	if !r.resolveConflict(ctx, &plan, &resp.Diagnostics) {
 		return
 	}
 
-	mapHostResponseToState(&result, &plan)
+	result, err := r.client.UpsertHost(ctx, body)
+	if err != nil {
+		addAPIFieldErrorDiagnostics(&resp.Diagnostics, "Error creating host", err, hostAPIFields)
+		return
+	}
+
+	mapHostResponseToState(result, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, hostResourceIdentityModel{Address: plan.Address})...)
+}
+
+// resolveConflict applies the on_conflict mode before a host is created. It
+// returns false when the host must not be created.
+func (r *hostResource) resolveConflict(ctx context.Context, plan *hostResourceModel, diags *diag.Diagnostics) bool {
+	mode := r.client.conflictMode(plan.OnConflict)
+	if mode == onConflictAdopt {
+		return true
+	}
+
+	address := plan.Address.ValueString()
+	existing, err := r.client.GetHostByAddress(tinymon.WithoutReadCache(ctx), address)
+	if isNotFound(err) {
+		return true
+	}
+	if err != nil {
+		addAPIErrorDiagnostics(diags, "Error looking up existing host", err)
+		return false
+	}
+
+	if mode == onConflictError {
+		diags.AddAttributeError(path.Root("address"), "Host already exists",
+			fmt.Sprintf("A host with address %s already exists in TinyMon (ID %d). Import it, or set on_conflict to \"adopt\" or \"overwrite\".",
+				address, existing.ID))
+		return false
+	}
+
+	if err := deleteHost(ctx, r.client, types.Int64Value(existing.ID), address); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(diags, "Error deleting existing host", err)
+		return false
+	}
+	return true
 }
 
 func (r *hostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -157,16 +263,29 @@ func (r *hostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	apiPath := "/api/push/hosts?address=" + url.QueryEscape(state.Address.ValueString())
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "read")
+	defer cancel()
+	ctx = withOrganization(ctx, state.Organization)
 
-	var result hostAPIResponse
-	if err := r.client.DoJSON("GET", apiPath, nil, &result); err != nil {
-		resp.Diagnostics.AddError("Error reading host", err.Error())
+	var result *tinymon.Host
+	var err error
+	if state.ID.IsNull() || state.ID.IsUnknown() || !r.client.Supports(featureIDEndpoints) {
+		result, err = r.client.GetHostByAddress(ctx, state.Address.ValueString())
+	} else {
+		result, err = r.client.GetHost(ctx, state.ID.ValueInt64())
+	}
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading host", err)
 		return
 	}
 
-	mapHostResponseToState(&result, &state)
+	mapHostResponseToState(result, &state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, hostResourceIdentityModel{Address: state.Address})...)
 }
 
 func (r *hostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -177,27 +296,42 @@ func (r *hostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts, "update")
+	defer cancel()
+	ctx = withOrganization(ctx, plan.Organization)
+
 	enabled := 1
 	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
 		enabled = 0
 	}
 
-	body := hostAPIRequest{
-		Address:     plan.Address.ValueString(),
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
-		Topic:       plan.Topic.ValueString(),
-		Enabled:     enabled,
+	tags := map[string]string{}
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	tagIDs := idSetFromPlan(ctx, plan.TagIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := tinymon.HostRequest{
+		Address:       plan.Address.ValueString(),
+		Name:          plan.Name.ValueString(),
+		Description:   plan.Description.ValueString(),
+		Topic:         plan.Topic.ValueString(),
+		ParentAddress: plan.ParentAddress.ValueString(),
+		Enabled:       enabled,
+		Tags:          tags,
+		TagIDs:        tagIDs,
 	}
 
-	var result hostAPIResponse
-	if err := r.client.DoJSON("POST", "/api/push/hosts", body, &result); err != nil {
-		resp.Diagnostics.AddError("Error updating host", err.Error())
+	result, err := r.client.UpsertHost(ctx, body)
+	if err != nil {
+		addAPIFieldErrorDiagnostics(&resp.Diagnostics, "Error updating host", err, hostAPIFields)
 		return
 	}
 
-	mapHostResponseToState(&result, &plan)
+	mapHostResponseToState(result, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, hostResourceIdentityModel{Address: plan.Address})...)
 }
 
 func (r *hostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -208,22 +342,62 @@ func (r *hostResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	body := hostDeleteRequest{Address: state.Address.ValueString()}
-	if err := r.client.DoJSON("DELETE", "/api/push/hosts", body, nil); err != nil {
-		resp.Diagnostics.AddError("Error deleting host", err.Error())
+	ctx, cancel := withTimeout(ctx, state.Timeouts, "delete")
+	defer cancel()
+	ctx = withOrganization(ctx, state.Organization)
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Host is protected from deletion",
+			fmt.Sprintf("Host %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying or replacing it.",
+				state.Address.ValueString()))
+		return
+	}
+
+	if err := deleteHost(ctx, r.client, state.ID, state.Address.ValueString()); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting host", err)
 		return
 	}
 }
 
 func (r *hostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), req.ID)...)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("address"), path.Root("address"), req, resp)
+	if req.ID != "" {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, hostResourceIdentityModel{Address: types.StringValue(req.ID)})...)
+	}
 }
 
-func mapHostResponseToState(apiResp *hostAPIResponse, state *hostResourceModel) {
+// deleteHost deletes a host by ID, or by address when the ID is unknown or
+// the server has no ID-based endpoints.
+func deleteHost(ctx context.Context, client *TinyMonClient, id types.Int64, address string) error {
+	if !id.IsNull() && !id.IsUnknown() && client.Supports(featureIDEndpoints) {
+		return client.DeleteHost(ctx, id.ValueInt64())
+	}
+	return client.DeleteHostByAddress(ctx, address)
+}
+
+func mapHostResponseToState(apiResp *tinymon.Host, state *hostResourceModel) {
 	state.ID = types.Int64Value(apiResp.ID)
 	state.Address = types.StringValue(apiResp.Address)
 	state.Name = types.StringValue(apiResp.Name)
 	state.Description = types.StringValue(apiResp.Description)
 	state.Topic = types.StringValue(apiResp.Topic)
+	state.ParentAddress = stringOrNull(apiResp.ParentAddress)
 	state.Enabled = types.BoolValue(apiResp.Enabled != 0)
+
+	tags := make(map[string]attr.Value, len(apiResp.Tags))
+	for k, v := range apiResp.Tags {
+		tags[k] = types.StringValue(v)
+	}
+	state.Tags = types.MapValueMust(types.StringType, tags)
+	state.TagIDs = idSetToState(apiResp.TagIDs, state.TagIDs)
+
+	// deletion_protection only exists in the provider; imported and listed
+	// hosts get the default.
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	if state.Timeouts.IsNull() {
+		// Gives a zero-valued model its attribute types.
+		state.Timeouts = types.ObjectNull(timeoutsAttrTypes)
+	}
 }
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+func TestHostResourceCRUD(t *testing.T) {
+	srv, client := newTestClient(t)
+	h := newResourceHarness(t, &hostResource{client: client})
+
+	state := h.create(map[string]tftypes.Value{
+		"address": tftypes.NewValue(tftypes.String, "web1.example.com"),
+		"name":    tftypes.NewValue(tftypes.String, "Web 1"),
+		"topic":   tftypes.NewValue(tftypes.String, "production"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"env": tftypes.NewValue(tftypes.String, "prod"),
+		}),
+	})
+	hosts := srv.Hosts()
+	if len(hosts) != 1 || hosts[0].Name != "Web 1" || hosts[0].Tags["env"] != "prod" || hosts[0].Enabled != 1 {
+		t.Fatalf("server hosts after create: %+v", hosts)
+	}
+	var id types.Int64
+	getAttribute(t, state, "id", &id)
+	if id.ValueInt64() != hosts[0].ID {
+		t.Fatalf("id in state = %s, want %d", id, hosts[0].ID)
+	}
+
+	// Changes made outside Terraform show up on refresh.
+	srv.AddHost(tinymon.HostRequest{Address: "web1.example.com", Name: "Renamed", Topic: "production", Enabled: 1})
+	state, ok := h.read(state)
+	if !ok {
+		t.Fatal("host removed from state on read")
+	}
+	var name types.String
+	getAttribute(t, state, "name", &name)
+	if name.ValueString() != "Renamed" {
+		t.Fatalf("name after read = %s, want %q", name, "Renamed")
+	}
+
+	state = h.update(state, map[string]tftypes.Value{
+		"address": tftypes.NewValue(tftypes.String, "web1.example.com"),
+		"name":    tftypes.NewValue(tftypes.String, "Web One"),
+		"topic":   tftypes.NewValue(tftypes.String, "staging"),
+		"enabled": tftypes.NewValue(tftypes.Bool, false),
+	})
+	hosts = srv.Hosts()
+	if len(hosts) != 1 || hosts[0].ID != id.ValueInt64() || hosts[0].Name != "Web One" || hosts[0].Topic != "staging" || hosts[0].Enabled != 0 {
+		t.Fatalf("server hosts after update: %+v", hosts)
+	}
+
+	h.delete(state)
+	if hosts := srv.Hosts(); len(hosts) != 0 {
+		t.Fatalf("server hosts after delete: %+v", hosts)
+	}
+
+	// A host deleted outside Terraform is removed from state.
+	if _, ok := h.read(state); ok {
+		t.Fatal("deleted host kept in state on read")
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &httpCheckConfigFunction{}
+
+func NewHTTPCheckConfigFunction() function.Function {
+	return &httpCheckConfigFunction{}
+}
+
+type httpCheckConfigFunction struct{}
+
+func (f *httpCheckConfigFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "http_check_config"
+}
+
+func (f *httpCheckConfigFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build the config string for an http check.",
+		Description: "Returns a canonical JSON config for a tinymon_check of type http. Pass null for status or keyword to omit them.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "url",
+				Description: "URL to request. Must use http or https.",
+			},
+			function.Int64Parameter{
+				Name:           "status",
+				Description:    "Expected HTTP status code, or null for the server default.",
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:           "keyword",
+				Description:    "Text that must appear in the response body, or null.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *httpCheckConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rawURL string
+	var status types.Int64
+	var keyword types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &rawURL, &status, &keyword))
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("url must be an absolute http or https URL, got %q", rawURL))
+		return
+	}
+
+	config := map[string]interface{}{
+		"url": rawURL,
+	}
+	if !status.IsNull() {
+		if status.ValueInt64() < 100 || status.ValueInt64() > 599 {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("status must be between 100 and 599, got %d", status.ValueInt64()))
+			return
+		}
+		config["expected_status"] = status.ValueInt64()
+	}
+	if !keyword.IsNull() && keyword.ValueString() != "" {
+		config["keyword"] = keyword.ValueString()
+	}
+
+	// encoding/json sorts map keys, which keeps the output stable.
+	data, err := json.Marshal(config)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("marshalling config: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(data)))
+}
@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &maintenanceWindowResource{}
+	_ resource.ResourceWithImportState    = &maintenanceWindowResource{}
+	_ resource.ResourceWithValidateConfig = &maintenanceWindowResource{}
+)
+
+func NewMaintenanceWindowResource() resource.Resource {
+	return &maintenanceWindowResource{}
+}
+
+type maintenanceWindowResource struct {
+	client *TinyMonClient
+}
+
+type maintenanceWindowResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Cron            types.String `tfsdk:"cron"`
+	RRule           types.String `tfsdk:"rrule"`
+	DurationMinutes types.Int64  `tfsdk:"duration_minutes"`
+	Timezone        types.String `tfsdk:"timezone"`
+	HostAddresses   types.Set    `tfsdk:"host_addresses"`
+	CheckIDs        types.Set    `tfsdk:"check_ids"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Active          types.Bool   `tfsdk:"active"`
+	NextStart       types.String `tfsdk:"next_start"`
+}
+
+// maintenanceWindowAPIRequest always carries both cron and rrule. The unset
+// one is sent as "", which clears it when a window switches between them.
+type maintenanceWindowAPIRequest struct {
+	Name            string   `json:"name"`
+	Cron            string   `json:"cron"`
+	RRule           string   `json:"rrule"`
+	DurationMinutes int64    `json:"duration_minutes"`
+	Timezone        string   `json:"timezone"`
+	HostAddresses   []string `json:"host_addresses"`
+	CheckIDs        []int64  `json:"check_ids"`
+	Enabled         bool     `json:"enabled"`
+}
+
+type maintenanceWindowAPIResponse struct {
+	ID              int64    `json:"id"`
+	Name            string   `json:"name"`
+	Cron            string   `json:"cron"`
+	RRule           string   `json:"rrule"`
+	DurationMinutes int64    `json:"duration_minutes"`
+	Timezone        string   `json:"timezone"`
+	HostAddresses   []string `json:"host_addresses"`
+	CheckIDs        []int64  `json:"check_ids"`
+	Enabled         bool     `json:"enabled"`
+	Active          bool     `json:"active"`
+	NextStart       string   `json:"next_start"`
+}
+
+func (r *maintenanceWindowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_window"
+}
+
+func (r *maintenanceWindowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a recurring maintenance window, e.g. every Sunday 02:00-04:00 UTC. Alerts for the mapped hosts and checks are suppressed while a window is open.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the maintenance window.",
+				Required:    true,
+			},
+			"cron": schema.StringAttribute{
+				Description: "Five-field cron expression for the start of each window, e.g. \"0 2 * * SUN\". Exactly one of cron and rrule is required.",
+				Optional:    true,
+				Validators: []validator.String{
+					cronExpression(),
+				},
+			},
+			"rrule": schema.StringAttribute{
+				Description: "RFC 5545 recurrence rule for the start of each window, e.g. \"FREQ=WEEKLY;BYDAY=SU;BYHOUR=2;BYMINUTE=0\". Exactly one of cron and rrule is required.",
+				Optional:    true,
+				Validators: []validator.String{
+					rrule(),
+				},
+			},
+			"duration_minutes": schema.Int64Attribute{
+				Description: "Length of each window in minutes (at most 7 days).",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 10080),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA time zone the schedule is evaluated in, e.g. Europe/Berlin.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("UTC"),
+				Validators: []validator.String{
+					timezone(),
+				},
+			},
+			"host_addresses": schema.SetAttribute{
+				Description: "Hosts whose checks are suppressed. At least one of host_addresses and check_ids is required.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"check_ids": schema.SetAttribute{
+				Description: "Individual checks that are suppressed. At least one of host_addresses and check_ids is required.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether a window is currently open. Updated on refresh.",
+				Computed:    true,
+			},
+			"next_start": schema.StringAttribute{
+				Description: "Start of the next window (RFC 3339). Null when disabled or the rule has no further occurrences. Updated on refresh.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *maintenanceWindowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *maintenanceWindowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config maintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case config.Cron.IsNull() && config.RRule.IsNull():
+		resp.Diagnostics.AddError("Missing maintenance schedule", "One of cron and rrule must be set.")
+	case !config.Cron.IsNull() && !config.RRule.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("rrule"), "Conflicting maintenance schedule",
+			"cron and rrule cannot both be set.")
+	}
+
+	if config.HostAddresses.IsNull() && config.CheckIDs.IsNull() {
+		resp.Diagnostics.AddError("Missing maintenance target",
+			"At least one of host_addresses and check_ids must be set.")
+	}
+}
+
+func (r *maintenanceWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan maintenanceWindowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := maintenanceWindowToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result maintenanceWindowAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/maintenance_windows", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating maintenance window", err)
+		return
+	}
+
+	mapMaintenanceWindowResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *maintenanceWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state maintenanceWindowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/maintenance_windows/%d", state.ID.ValueInt64())
+
+	var result maintenanceWindowAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading maintenance window", err)
+		return
+	}
+
+	mapMaintenanceWindowResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *maintenanceWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan maintenanceWindowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := maintenanceWindowToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/maintenance_windows/%d", plan.ID.ValueInt64())
+
+	var result maintenanceWindowAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating maintenance window", err)
+		return
+	}
+
+	mapMaintenanceWindowResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *maintenanceWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state maintenanceWindowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/maintenance_windows/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting maintenance window", err)
+		return
+	}
+}
+
+func (r *maintenanceWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric maintenance window ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// maintenanceWindowToAPI always sends the targets, so unset ones clear
+// targets set earlier.
+func maintenanceWindowToAPI(ctx context.Context, plan *maintenanceWindowResourceModel) (maintenanceWindowAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hostAddresses := []string{}
+	if !plan.HostAddresses.IsNull() {
+		diags.Append(plan.HostAddresses.ElementsAs(ctx, &hostAddresses, false)...)
+	}
+
+	body := maintenanceWindowAPIRequest{
+		Name:            plan.Name.ValueString(),
+		Cron:            plan.Cron.ValueString(),
+		RRule:           plan.RRule.ValueString(),
+		DurationMinutes: plan.DurationMinutes.ValueInt64(),
+		Timezone:        plan.Timezone.ValueString(),
+		HostAddresses:   hostAddresses,
+		CheckIDs:        idSetFromPlan(ctx, plan.CheckIDs, &diags),
+		Enabled:         plan.Enabled.ValueBool(),
+	}
+	return body, diags
+}
+
+func mapMaintenanceWindowResponseToState(apiResp *maintenanceWindowAPIResponse, state *maintenanceWindowResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.Cron = stringOrNull(apiResp.Cron)
+	state.RRule = stringOrNull(apiResp.RRule)
+	state.DurationMinutes = types.Int64Value(apiResp.DurationMinutes)
+	state.Timezone = types.StringValue(apiResp.Timezone)
+	state.HostAddresses = stringSetToState(apiResp.HostAddresses, state.HostAddresses)
+	state.CheckIDs = idSetToState(apiResp.CheckIDs, state.CheckIDs)
+	state.Enabled = types.BoolValue(apiResp.Enabled)
+	state.Active = types.BoolValue(apiResp.Active)
+	state.NextStart = stringOrNull(apiResp.NextStart)
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                   = &metricsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &metricsDataSource{}
+)
+
+// Bounds of a metrics query. They keep the response to a size Terraform
+// state handles comfortably.
+const (
+	metricsMaxWindow         = 30 * 24 * time.Hour
+	metricsMinResolution     = time.Minute
+	metricsMaxDatapoints     = 1000
+	metricsDefaultWindow     = "24h"
+	metricsDefaultResolution = "1h"
+)
+
+func NewMetricsDataSource() datasource.DataSource {
+	return &metricsDataSource{}
+}
+
+type metricsDataSource struct {
+	client *TinyMonClient
+}
+
+type metricsDataSourceModel struct {
+	CheckID    types.Int64                       `tfsdk:"check_id"`
+	Window     types.String                      `tfsdk:"window"`
+	Resolution types.String                      `tfsdk:"resolution"`
+	Datapoints []metricsDataSourceDatapointModel `tfsdk:"datapoints"`
+}
+
+type metricsDataSourceDatapointModel struct {
+	Timestamp    types.String `tfsdk:"timestamp"`
+	LatencyMS    types.Int64  `tfsdk:"latency_ms"`
+	MaxLatencyMS types.Int64  `tfsdk:"max_latency_ms"`
+	Status       types.String `tfsdk:"status"`
+}
+
+type metricsDatapointAPIResponse struct {
+	Timestamp    string `json:"timestamp"`
+	LatencyMS    *int64 `json:"latency_ms"`
+	MaxLatencyMS *int64 `json:"max_latency_ms"`
+	Status       string `json:"status"`
+}
+
+func (d *metricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metrics"
+}
+
+func (d *metricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns recent latency and status datapoints of a check, aggregated into buckets of a fixed resolution.",
+		Attributes: map[string]schema.Attribute{
+			"check_id": schema.Int64Attribute{
+				Description: "ID of the check.",
+				Required:    true,
+			},
+			"window": schema.StringAttribute{
+				Description: "How far back to report, as a duration such as 6h or 168h (at most 720h). Defaults to 24h.",
+				Optional:    true,
+				Validators: []validator.String{
+					duration(),
+				},
+			},
+			"resolution": schema.StringAttribute{
+				Description: "Bucket size, as a duration such as 5m or 1h (at least 1m). Defaults to 1h. At most 1000 buckets per window.",
+				Optional:    true,
+				Validators: []validator.String{
+					duration(),
+				},
+			},
+			"datapoints": schema.ListNestedAttribute{
+				Description: "One datapoint per bucket, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Description: "Start of the bucket in RFC 3339 format.",
+							Computed:    true,
+						},
+						"latency_ms": schema.Int64Attribute{
+							Description: "Average latency in the bucket. Null if the check did not run.",
+							Computed:    true,
+						},
+						"max_latency_ms": schema.Int64Attribute{
+							Description: "Highest latency in the bucket. Null if the check did not run.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Worst status in the bucket (ok, warning, critical, unknown), or none if the check did not run.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *metricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *metricsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config metricsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Window.IsUnknown() || config.Resolution.IsUnknown() {
+		return
+	}
+
+	// Malformed durations are reported by the attribute validators.
+	window, resolution, err := metricsRange(config)
+	if err != nil {
+		return
+	}
+
+	switch {
+	case window > metricsMaxWindow:
+		resp.Diagnostics.AddAttributeError(path.Root("window"), "Invalid window",
+			"window must not exceed 720h (30 days).")
+	case resolution < metricsMinResolution:
+		resp.Diagnostics.AddAttributeError(path.Root("resolution"), "Invalid resolution",
+			"resolution must be at least 1m.")
+	case resolution > window:
+		resp.Diagnostics.AddAttributeError(path.Root("resolution"), "Invalid resolution",
+			"resolution must not exceed window.")
+	case window/resolution > metricsMaxDatapoints:
+		resp.Diagnostics.AddAttributeError(path.Root("resolution"), "Too many datapoints",
+			fmt.Sprintf("The window holds %d buckets of this resolution; at most %d are allowed. Use a coarser resolution.",
+				window/resolution, metricsMaxDatapoints))
+	}
+}
+
+func (d *metricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state metricsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, resolution, err := metricsRange(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid metrics range", err.Error())
+		return
+	}
+
+	query := url.Values{}
+	query.Set("window_seconds", strconv.FormatInt(int64(window/time.Second), 10))
+	query.Set("resolution_seconds", strconv.FormatInt(int64(resolution/time.Second), 10))
+	apiPath := fmt.Sprintf("/api/push/checks/%d/metrics?%s", state.CheckID.ValueInt64(), query.Encode())
+
+	var result []metricsDatapointAPIResponse
+	if err := d.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading check metrics", err)
+		return
+	}
+
+	state.Datapoints = make([]metricsDataSourceDatapointModel, 0, len(result))
+	for _, point := range result {
+		status := point.Status
+		if status == "" {
+			status = "none"
+		}
+		state.Datapoints = append(state.Datapoints, metricsDataSourceDatapointModel{
+			Timestamp:    types.StringValue(point.Timestamp),
+			LatencyMS:    types.Int64PointerValue(point.LatencyMS),
+			MaxLatencyMS: types.Int64PointerValue(point.MaxLatencyMS),
+			Status:       types.StringValue(status),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// metricsRange parses window and resolution, applying the defaults.
+func metricsRange(config metricsDataSourceModel) (time.Duration, time.Duration, error) {
+	windowValue := metricsDefaultWindow
+	if !config.Window.IsNull() {
+		windowValue = config.Window.ValueString()
+	}
+	resolutionValue := metricsDefaultResolution
+	if !config.Resolution.IsNull() {
+		resolutionValue = config.Resolution.ValueString()
+	}
+
+	window, err := time.ParseDuration(windowValue)
+	if err != nil {
+		return 0, 0, fmt.Errorf("window: %w", err)
+	}
+	resolution, err := time.ParseDuration(resolutionValue)
+	if err != nil {
+		return 0, 0, fmt.Errorf("resolution: %w", err)
+	}
+	if window <= 0 || resolution <= 0 {
+		return 0, 0, fmt.Errorf("window and resolution must be positive")
+	}
+	return window, resolution, nil
+}
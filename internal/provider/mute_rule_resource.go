@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &muteRuleResource{}
+	_ resource.ResourceWithImportState    = &muteRuleResource{}
+	_ resource.ResourceWithValidateConfig = &muteRuleResource{}
+)
+
+func NewMuteRuleResource() resource.Resource {
+	return &muteRuleResource{}
+}
+
+type muteRuleResource struct {
+	client *TinyMonClient
+}
+
+type muteRuleResourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Comment      types.String `tfsdk:"comment"`
+	TagIDs       types.Set    `tfsdk:"tag_ids"`
+	CheckTypes   types.Set    `tfsdk:"check_types"`
+	MessageRegex types.String `tfsdk:"message_regex"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+}
+
+type muteRuleAPIRequest struct {
+	Name         string   `json:"name"`
+	Comment      string   `json:"comment,omitempty"`
+	TagIDs       []int64  `json:"tag_ids"`
+	CheckTypes   []string `json:"check_types"`
+	MessageRegex string   `json:"message_regex,omitempty"`
+	Enabled      bool     `json:"enabled"`
+}
+
+type muteRuleAPIResponse struct {
+	ID           int64    `json:"id"`
+	Name         string   `json:"name"`
+	Comment      string   `json:"comment"`
+	TagIDs       []int64  `json:"tag_ids"`
+	CheckTypes   []string `json:"check_types"`
+	MessageRegex string   `json:"message_regex"`
+	Enabled      bool     `json:"enabled"`
+}
+
+func (r *muteRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mute_rule"
+}
+
+func (r *muteRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a persistent mute rule. Alerts matching every configured matcher are recorded but not notified.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the mute rule.",
+				Required:    true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "Why the condition is muted, shown in the UI.",
+				Optional:    true,
+			},
+			"tag_ids": schema.SetAttribute{
+				Description: "Only mute alerts of checks carrying one of these tags (tinymon_tag).",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"check_types": schema.SetAttribute{
+				Description: "Only mute alerts of checks of these types.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setValuesOneOf(knownCheckTypes...),
+				},
+			},
+			"message_regex": schema.StringAttribute{
+				Description: "Only mute alerts whose message matches this regular expression (RE2 syntax).",
+				Optional:    true,
+				Validators: []validator.String{
+					regularExpression(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *muteRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig rejects rules without matchers, which would mute every alert.
+func (r *muteRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config muteRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.TagIDs.IsNull() && config.CheckTypes.IsNull() && config.MessageRegex.IsNull() {
+		resp.Diagnostics.AddError("Missing mute rule matcher",
+			"At least one of tag_ids, check_types and message_regex must be set.")
+	}
+}
+
+func (r *muteRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan muteRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := muteRuleToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result muteRuleAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/mute_rules", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating mute rule", err)
+		return
+	}
+
+	mapMuteRuleResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *muteRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state muteRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/mute_rules/%d", state.ID.ValueInt64())
+
+	var result muteRuleAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading mute rule", err)
+		return
+	}
+
+	mapMuteRuleResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *muteRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan muteRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := muteRuleToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/mute_rules/%d", plan.ID.ValueInt64())
+
+	var result muteRuleAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating mute rule", err)
+		return
+	}
+
+	mapMuteRuleResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *muteRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state muteRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/mute_rules/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting mute rule", err)
+		return
+	}
+}
+
+func (r *muteRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric mute rule ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// muteRuleToAPI always sends the set matchers, so unset ones clear matchers
+// set earlier.
+func muteRuleToAPI(ctx context.Context, plan *muteRuleResourceModel) (muteRuleAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	checkTypes := []string{}
+	if !plan.CheckTypes.IsNull() {
+		diags.Append(plan.CheckTypes.ElementsAs(ctx, &checkTypes, false)...)
+	}
+
+	body := muteRuleAPIRequest{
+		Name:         plan.Name.ValueString(),
+		Comment:      plan.Comment.ValueString(),
+		TagIDs:       idSetFromPlan(ctx, plan.TagIDs, &diags),
+		CheckTypes:   checkTypes,
+		MessageRegex: plan.MessageRegex.ValueString(),
+		Enabled:      plan.Enabled.ValueBool(),
+	}
+	return body, diags
+}
+
+func mapMuteRuleResponseToState(apiResp *muteRuleAPIResponse, state *muteRuleResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.Comment = stringOrNull(apiResp.Comment)
+	state.TagIDs = idSetToState(apiResp.TagIDs, state.TagIDs)
+	state.CheckTypes = stringSetToState(apiResp.CheckTypes, state.CheckTypes)
+	state.MessageRegex = stringOrNull(apiResp.MessageRegex)
+	state.Enabled = types.BoolValue(apiResp.Enabled)
+}
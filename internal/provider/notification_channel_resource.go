@@ -0,0 +1,387 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ resource.Resource                   = &notificationChannelResource{}
+	_ resource.ResourceWithImportState    = &notificationChannelResource{}
+	_ resource.ResourceWithValidateConfig = &notificationChannelResource{}
+)
+
+// slackWebhookURL matches incoming webhook and workflow URLs issued by Slack.
+var slackWebhookURL = regexp.MustCompile(`^https://hooks\.slack\.com/(services|workflows|triggers)/[A-Za-z0-9/_-]+$`)
+
+var slackChannelAttrTypes = map[string]attr.Type{
+	"webhook_url":      types.StringType,
+	"channel":          types.StringType,
+	"mention_groups":   types.ListType{ElemType: types.StringType},
+	"message_template": types.StringType,
+}
+
+func NewNotificationChannelResource() resource.Resource {
+	return &notificationChannelResource{}
+}
+
+type notificationChannelResource struct {
+	client *TinyMonClient
+}
+
+type notificationChannelResourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Target     types.String `tfsdk:"target"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	ContactIDs types.Set    `tfsdk:"contact_ids"`
+	Slack      types.Object `tfsdk:"slack"`
+}
+
+type notificationChannelSlackModel struct {
+	WebhookURL      types.String `tfsdk:"webhook_url"`
+	Channel         types.String `tfsdk:"channel"`
+	MentionGroups   types.List   `tfsdk:"mention_groups"`
+	MessageTemplate types.String `tfsdk:"message_template"`
+}
+
+type notificationChannelAPIRequest struct {
+	Name       string                        `json:"name"`
+	Type       string                        `json:"type"`
+	Target     string                        `json:"target,omitempty"`
+	Enabled    int                           `json:"enabled"`
+	ContactIDs []int64                       `json:"contact_ids"`
+	Slack      *notificationChannelSlackJSON `json:"slack,omitempty"`
+}
+
+// notificationChannelAPIResponse never includes the Slack webhook URL; it is
+// write-only on the server and kept from the configuration.
+type notificationChannelAPIResponse struct {
+	ID         int64                         `json:"id"`
+	Name       string                        `json:"name"`
+	Type       string                        `json:"type"`
+	Target     string                        `json:"target"`
+	Enabled    int                           `json:"enabled"`
+	ContactIDs []int64                       `json:"contact_ids"`
+	Slack      *notificationChannelSlackJSON `json:"slack"`
+}
+
+type notificationChannelSlackJSON struct {
+	WebhookURL      string   `json:"webhook_url,omitempty"`
+	Channel         string   `json:"channel,omitempty"`
+	MentionGroups   []string `json:"mention_groups,omitempty"`
+	MessageTemplate string   `json:"message_template,omitempty"`
+}
+
+func (r *notificationChannelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+func (r *notificationChannelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a notification channel that alerts are delivered to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the channel.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Channel type (email, slack, webhook, telegram).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringOneOf("email", "slack", "webhook", "telegram"),
+				},
+			},
+			"target": schema.StringAttribute{
+				Description: "Destination for channel types without a typed block, e.g. an email address or URL. Not used with slack. Optional for email when contact_ids is set.",
+				Optional:    true,
+			},
+			"contact_ids": schema.SetAttribute{
+				Description: "IDs of tinymon_contact resources notified through this channel, using their email address. Only used with type email.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			// Attributes are Optional because the framework enforces Required
+			// attributes of a single nested block even when it is absent.
+			"slack": schema.SingleNestedBlock{
+				Description: "Slack settings. Required when type is slack.",
+				Attributes: map[string]schema.Attribute{
+					"webhook_url": schema.StringAttribute{
+						Description: "Slack incoming webhook URL (https://hooks.slack.com/...). Not returned by the server. Required.",
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringMatches(slackWebhookURL, "a Slack webhook URL like https://hooks.slack.com/services/T000/B000/XXXX"),
+						},
+					},
+					"channel": schema.StringAttribute{
+						Description: "Channel override, e.g. #ops. Defaults to the webhook's channel.",
+						Optional:    true,
+					},
+					"mention_groups": schema.ListAttribute{
+						Description: "Slack user group handles mentioned on critical alerts, e.g. [\"oncall\"].",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"message_template": schema.StringAttribute{
+						Description: "Go template for the message text. Defaults to TinyMon's alert summary.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *notificationChannelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *notificationChannelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config notificationChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() {
+		return
+	}
+
+	isSlack := config.Type.ValueString() == "slack"
+	switch {
+	case isSlack && config.Slack.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("slack"), "Missing slack block",
+			"A slack block is required when type is slack.")
+	case !isSlack && !config.Slack.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("slack"), "Unexpected slack block",
+			fmt.Sprintf("The slack block can only be used with type = \"slack\", got %q.", config.Type.ValueString()))
+	case isSlack && !config.Target.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("target"), "Conflicting channel config",
+			"target cannot be used with type = \"slack\"; set slack.webhook_url instead.")
+	case !config.ContactIDs.IsNull() && config.Type.ValueString() != "email":
+		resp.Diagnostics.AddAttributeError(path.Root("contact_ids"), "Unexpected contact_ids",
+			fmt.Sprintf("contact_ids can only be used with type = \"email\", got %q.", config.Type.ValueString()))
+	case !isSlack && config.Target.IsNull() && config.ContactIDs.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("target"), "Missing target",
+			fmt.Sprintf("target is required for type = %q.", config.Type.ValueString()))
+	}
+
+	if isSlack && !config.Slack.IsNull() && !config.Slack.IsUnknown() {
+		if webhookURL, ok := config.Slack.Attributes()["webhook_url"]; ok && webhookURL.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("slack").AtName("webhook_url"), "Missing required argument",
+				"The argument \"webhook_url\" is required in slack.")
+		}
+	}
+}
+
+func (r *notificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan notificationChannelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := notificationChannelToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result notificationChannelAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/notification_channels", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating notification channel", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapNotificationChannelResponseToState(ctx, &result, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *notificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state notificationChannelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/notification_channels/%d", state.ID.ValueInt64())
+
+	var result notificationChannelAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading notification channel", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapNotificationChannelResponseToState(ctx, &result, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *notificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan notificationChannelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := notificationChannelToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/notification_channels/%d", plan.ID.ValueInt64())
+
+	var result notificationChannelAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating notification channel", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapNotificationChannelResponseToState(ctx, &result, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *notificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state notificationChannelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/notification_channels/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting notification channel", err)
+		return
+	}
+}
+
+func (r *notificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric notification channel ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func notificationChannelToAPI(ctx context.Context, plan *notificationChannelResourceModel) (notificationChannelAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+
+	body := notificationChannelAPIRequest{
+		Name:       plan.Name.ValueString(),
+		Type:       plan.Type.ValueString(),
+		Target:     plan.Target.ValueString(),
+		Enabled:    enabled,
+		ContactIDs: idSetFromPlan(ctx, plan.ContactIDs, &diags),
+	}
+
+	if !plan.Slack.IsNull() {
+		var slack notificationChannelSlackModel
+		diags.Append(plan.Slack.As(ctx, &slack, basetypes.ObjectAsOptions{})...)
+		mentionGroups := []string{}
+		if !slack.MentionGroups.IsNull() {
+			diags.Append(slack.MentionGroups.ElementsAs(ctx, &mentionGroups, false)...)
+		}
+		body.Slack = &notificationChannelSlackJSON{
+			WebhookURL:      slack.WebhookURL.ValueString(),
+			Channel:         slack.Channel.ValueString(),
+			MentionGroups:   mentionGroups,
+			MessageTemplate: slack.MessageTemplate.ValueString(),
+		}
+	}
+
+	return body, diags
+}
+
+func mapNotificationChannelResponseToState(ctx context.Context, apiResp *notificationChannelAPIResponse, state *notificationChannelResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.Type = types.StringValue(apiResp.Type)
+	state.Target = stringOrNull(apiResp.Target)
+	state.Enabled = types.BoolValue(apiResp.Enabled != 0)
+	state.ContactIDs = idSetToState(apiResp.ContactIDs, state.ContactIDs)
+
+	if apiResp.Slack == nil {
+		state.Slack = types.ObjectNull(slackChannelAttrTypes)
+		return diags
+	}
+
+	// The webhook URL is write-only, so it is carried over from state.
+	webhookURL := types.StringNull()
+	if !state.Slack.IsNull() && !state.Slack.IsUnknown() {
+		if value, ok := state.Slack.Attributes()["webhook_url"].(types.String); ok {
+			webhookURL = value
+		}
+	}
+
+	mentionGroups := types.ListNull(types.StringType)
+	if len(apiResp.Slack.MentionGroups) > 0 {
+		var d diag.Diagnostics
+		mentionGroups, d = types.ListValueFrom(ctx, types.StringType, apiResp.Slack.MentionGroups)
+		diags.Append(d...)
+	}
+
+	slack, d := types.ObjectValueFrom(ctx, slackChannelAttrTypes, notificationChannelSlackModel{
+		WebhookURL:      webhookURL,
+		Channel:         stringOrNull(apiResp.Slack.Channel),
+		MentionGroups:   mentionGroups,
+		MessageTemplate: stringOrNull(apiResp.Slack.MessageTemplate),
+	})
+	diags.Append(d...)
+	state.Slack = slack
+	return diags
+}
@@ -0,0 +1,465 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template/parse"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &notificationTemplateResource{}
+	_ resource.ResourceWithImportState    = &notificationTemplateResource{}
+	_ resource.ResourceWithValidateConfig = &notificationTemplateResource{}
+)
+
+// notificationTemplateVariables are the fields of the alert passed to
+// notification templates, e.g. {{ .Host }}.
+var notificationTemplateVariables = []string{
+	"Host",
+	"HostAddress",
+	"Check",
+	"CheckType",
+	"Status",
+	"PreviousStatus",
+	"Severity",
+	"Message",
+	"Topic",
+	"Time",
+	"URL",
+}
+
+// notificationTemplateVariants are the channel types a template can override
+// the default wording for. Only email messages have a subject.
+var notificationTemplateVariants = []struct {
+	channel string
+	subject bool
+}{
+	{"email", true},
+	{"slack", false},
+	{"telegram", false},
+	{"webhook", false},
+}
+
+func notificationTemplateVariantAttrTypes(subject bool) map[string]attr.Type {
+	attrTypes := map[string]attr.Type{"body": types.StringType}
+	if subject {
+		attrTypes["subject"] = types.StringType
+	}
+	return attrTypes
+}
+
+func NewNotificationTemplateResource() resource.Resource {
+	return &notificationTemplateResource{}
+}
+
+type notificationTemplateResource struct {
+	client *TinyMonClient
+}
+
+type notificationTemplateResourceModel struct {
+	ID                     types.Int64  `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Subject                types.String `tfsdk:"subject"`
+	Body                   types.String `tfsdk:"body"`
+	NotificationChannelIDs types.Set    `tfsdk:"notification_channel_ids"`
+	Email                  types.Object `tfsdk:"email"`
+	Slack                  types.Object `tfsdk:"slack"`
+	Telegram               types.Object `tfsdk:"telegram"`
+	Webhook                types.Object `tfsdk:"webhook"`
+}
+
+// variant returns the block holding the variant for a channel type.
+func (m *notificationTemplateResourceModel) variant(channel string) *types.Object {
+	switch channel {
+	case "email":
+		return &m.Email
+	case "slack":
+		return &m.Slack
+	case "telegram":
+		return &m.Telegram
+	default:
+		return &m.Webhook
+	}
+}
+
+type notificationTemplateAPIRequest struct {
+	Name                   string                                     `json:"name"`
+	Subject                string                                     `json:"subject"`
+	Body                   string                                     `json:"body"`
+	NotificationChannelIDs []int64                                    `json:"notification_channel_ids"`
+	Variants               map[string]notificationTemplateVariantJSON `json:"variants"`
+}
+
+type notificationTemplateAPIResponse struct {
+	ID                     int64                                      `json:"id"`
+	Name                   string                                     `json:"name"`
+	Subject                string                                     `json:"subject"`
+	Body                   string                                     `json:"body"`
+	NotificationChannelIDs []int64                                    `json:"notification_channel_ids"`
+	Variants               map[string]notificationTemplateVariantJSON `json:"variants"`
+}
+
+type notificationTemplateVariantJSON struct {
+	Subject string `json:"subject,omitempty"`
+	Body    string `json:"body,omitempty"`
+}
+
+func (r *notificationTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_template"
+}
+
+func (r *notificationTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	variables := "{{ ." + strings.Join(notificationTemplateVariables, " }}, {{ .") + " }}"
+
+	blocks := map[string]schema.Block{}
+	for _, v := range notificationTemplateVariants {
+		// Attributes are Optional because the framework enforces Required
+		// attributes of a single nested block even when it is absent.
+		attributes := map[string]schema.Attribute{
+			"body": schema.StringAttribute{
+				Description: fmt.Sprintf("Go template for the message text sent through %s channels. Defaults to body.", v.channel),
+				Optional:    true,
+				Validators: []validator.String{
+					notificationTemplateText(),
+				},
+			},
+		}
+		if v.subject {
+			attributes["subject"] = schema.StringAttribute{
+				Description: fmt.Sprintf("Go template for the subject of %s messages. Defaults to subject.", v.channel),
+				Optional:    true,
+				Validators: []validator.String{
+					notificationTemplateText(),
+				},
+			}
+		}
+		blocks[v.channel] = schema.SingleNestedBlock{
+			Description: fmt.Sprintf("Wording used for %s channels instead of subject and body.", v.channel),
+			Attributes:  attributes,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a notification template: the wording of alert messages, with optional variants per channel type. Templates use Go template syntax with the variables " + variables + ".",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the template.",
+				Required:    true,
+			},
+			"subject": schema.StringAttribute{
+				Description: "Go template for the subject or headline of alert messages.",
+				Required:    true,
+				Validators: []validator.String{
+					notificationTemplateText(),
+				},
+			},
+			"body": schema.StringAttribute{
+				Description: "Go template for the message text.",
+				Required:    true,
+				Validators: []validator.String{
+					notificationTemplateText(),
+				},
+			},
+			"notification_channel_ids": schema.SetAttribute{
+				Description: "Notification channels that use this template. Other channels keep TinyMon's default wording.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+		},
+		Blocks: blocks,
+	}
+}
+
+func (r *notificationTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig rejects variant blocks that override nothing.
+func (r *notificationTemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config notificationTemplateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, v := range notificationTemplateVariants {
+		block := config.variant(v.channel)
+		if block.IsNull() || block.IsUnknown() {
+			continue
+		}
+		empty := true
+		for _, value := range block.Attributes() {
+			if !value.IsNull() {
+				empty = false
+			}
+		}
+		if empty {
+			resp.Diagnostics.AddAttributeError(path.Root(v.channel), "Empty template variant",
+				fmt.Sprintf("The %s block must override at least one of the template's texts.", v.channel))
+		}
+	}
+}
+
+func (r *notificationTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan notificationTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := notificationTemplateToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result notificationTemplateAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/notification_templates", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating notification template", err)
+		return
+	}
+
+	mapNotificationTemplateResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *notificationTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state notificationTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/notification_templates/%d", state.ID.ValueInt64())
+
+	var result notificationTemplateAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading notification template", err)
+		return
+	}
+
+	mapNotificationTemplateResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *notificationTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan notificationTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := notificationTemplateToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/notification_templates/%d", plan.ID.ValueInt64())
+
+	var result notificationTemplateAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating notification template", err)
+		return
+	}
+
+	mapNotificationTemplateResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *notificationTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state notificationTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/notification_templates/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting notification template", err)
+		return
+	}
+}
+
+func (r *notificationTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric template ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// notificationTemplateToAPI sends only the variants configured, so removing
+// a block drops the variant on the server.
+func notificationTemplateToAPI(ctx context.Context, plan *notificationTemplateResourceModel) (notificationTemplateAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := notificationTemplateAPIRequest{
+		Name:                   plan.Name.ValueString(),
+		Subject:                plan.Subject.ValueString(),
+		Body:                   plan.Body.ValueString(),
+		NotificationChannelIDs: idSetFromPlan(ctx, plan.NotificationChannelIDs, &diags),
+		Variants:               map[string]notificationTemplateVariantJSON{},
+	}
+
+	for _, v := range notificationTemplateVariants {
+		block := plan.variant(v.channel)
+		if block.IsNull() {
+			continue
+		}
+		attrs := block.Attributes()
+		var variant notificationTemplateVariantJSON
+		if value, ok := attrs["subject"].(types.String); ok {
+			variant.Subject = value.ValueString()
+		}
+		if value, ok := attrs["body"].(types.String); ok {
+			variant.Body = value.ValueString()
+		}
+		body.Variants[v.channel] = variant
+	}
+
+	return body, diags
+}
+
+func mapNotificationTemplateResponseToState(apiResp *notificationTemplateAPIResponse, state *notificationTemplateResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.Subject = types.StringValue(apiResp.Subject)
+	state.Body = types.StringValue(apiResp.Body)
+	state.NotificationChannelIDs = idSetToState(apiResp.NotificationChannelIDs, state.NotificationChannelIDs)
+
+	for _, v := range notificationTemplateVariants {
+		attrTypes := notificationTemplateVariantAttrTypes(v.subject)
+		variant, ok := apiResp.Variants[v.channel]
+		if !ok {
+			*state.variant(v.channel) = types.ObjectNull(attrTypes)
+			continue
+		}
+		attrs := map[string]attr.Value{"body": stringOrNull(variant.Body)}
+		if v.subject {
+			attrs["subject"] = stringOrNull(variant.Subject)
+		}
+		*state.variant(v.channel) = types.ObjectValueMust(attrTypes, attrs)
+	}
+}
+
+var _ validator.String = notificationTemplateTextValidator{}
+
+// notificationTemplateTextValidator rejects Go templates that do not parse or
+// that reference alert fields outside notificationTemplateVariables. Function
+// names are left to the server, which knows its template functions.
+type notificationTemplateTextValidator struct{}
+
+func notificationTemplateText() validator.String {
+	return notificationTemplateTextValidator{}
+}
+
+func (v notificationTemplateTextValidator) Description(_ context.Context) string {
+	return "value must be a Go template using the variables " + strings.Join(notificationTemplateVariables, ", ")
+}
+
+func (v notificationTemplateTextValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v notificationTemplateTextValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	tree := parse.New("template")
+	tree.Mode = parse.SkipFuncCheck
+	if _, err := tree.Parse(req.ConfigValue.ValueString(), "", "", map[string]*parse.Tree{}); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid notification template",
+			fmt.Sprintf("Attribute %s is not a valid Go template: %s", req.Path, err))
+		return
+	}
+
+	for _, name := range templateFields(tree.Root) {
+		if !slices.Contains(notificationTemplateVariables, name) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Unknown template variable",
+				fmt.Sprintf("Attribute %s references {{ .%s }}; available variables are %s.",
+					req.Path, name, strings.Join(notificationTemplateVariables, ", ")))
+		}
+	}
+}
+
+// templateFields returns the first field name of every {{ .Field }} reference
+// made while dot is the alert. Bodies of range and with, where dot changes,
+// are not inspected.
+func templateFields(node parse.Node) []string {
+	var fields []string
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			fields = append(fields, templateFields(child)...)
+		}
+	case *parse.ActionNode:
+		fields = append(fields, templateFields(n.Pipe)...)
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+		for _, cmd := range n.Cmds {
+			fields = append(fields, templateFields(cmd)...)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			fields = append(fields, templateFields(arg)...)
+		}
+	case *parse.FieldNode:
+		fields = append(fields, n.Ident[0])
+	case *parse.IfNode:
+		fields = append(fields, templateFields(n.Pipe)...)
+		fields = append(fields, templateFields(n.List)...)
+		fields = append(fields, templateFields(n.ElseList)...)
+	case *parse.RangeNode:
+		fields = append(fields, templateFields(n.Pipe)...)
+		fields = append(fields, templateFields(n.ElseList)...)
+	case *parse.WithNode:
+		fields = append(fields, templateFields(n.Pipe)...)
+		fields = append(fields, templateFields(n.ElseList)...)
+	}
+	return fields
+}
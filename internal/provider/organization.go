@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+// featureOrganizations covers the organization header. Older servers have a
+// single tenant and ignore it.
+var featureOrganizations = serverFeature{name: "organizations", since: serverVersion{1, 10, 0}}
+
+// organizationSchema is the organization attribute of resources that can live
+// in a different organization than the provider's (hosts and checks).
+func organizationSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Organization (slug) the object belongs to. Defaults to the provider's organization. Changing it recreates the object in the new organization.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// withOrganization scopes all requests made with ctx to the resource's
+// organization. A null or unknown override keeps the provider's.
+func withOrganization(ctx context.Context, override types.String) context.Context {
+	if override.IsNull() || override.IsUnknown() {
+		return ctx
+	}
+	return tinymon.WithOrganization(ctx, override.ValueString())
+}
@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &pagerDutyIntegrationResource{}
+	_ resource.ResourceWithImportState    = &pagerDutyIntegrationResource{}
+	_ resource.ResourceWithValidateConfig = &pagerDutyIntegrationResource{}
+)
+
+// pagerDutyRoutingKey matches Events API v2 integration keys.
+var pagerDutyRoutingKey = regexp.MustCompile(`^[a-zA-Z0-9]{32}$`)
+
+var (
+	pagerDutyCheckStatuses = []string{"critical", "warning", "unknown"}
+	pagerDutySeverities    = []string{"critical", "error", "warning", "info"}
+)
+
+func NewPagerDutyIntegrationResource() resource.Resource {
+	return &pagerDutyIntegrationResource{}
+}
+
+type pagerDutyIntegrationResource struct {
+	client *TinyMonClient
+}
+
+type pagerDutyIntegrationResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	RoutingKey      types.String `tfsdk:"routing_key"`
+	SeverityMapping types.Map    `tfsdk:"severity_mapping"`
+	AutoResolve     types.Bool   `tfsdk:"auto_resolve"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+}
+
+type pagerDutyIntegrationAPIRequest struct {
+	Name            string            `json:"name"`
+	RoutingKey      string            `json:"routing_key"`
+	SeverityMapping map[string]string `json:"severity_mapping"`
+	AutoResolve     int               `json:"auto_resolve"`
+	Enabled         int               `json:"enabled"`
+}
+
+// pagerDutyIntegrationAPIResponse never includes the routing key; it is
+// write-only on the server and kept from the configuration.
+type pagerDutyIntegrationAPIResponse struct {
+	ID              int64             `json:"id"`
+	Name            string            `json:"name"`
+	SeverityMapping map[string]string `json:"severity_mapping"`
+	AutoResolve     int               `json:"auto_resolve"`
+	Enabled         int               `json:"enabled"`
+}
+
+func (r *pagerDutyIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pagerduty_integration"
+}
+
+func (r *pagerDutyIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a PagerDuty integration. Alerts open PagerDuty incidents through the Events API v2 and can resolve them on recovery.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the integration.",
+				Required:    true,
+			},
+			"routing_key": schema.StringAttribute{
+				Description: "Events API v2 integration key of the PagerDuty service. Not returned by the server.",
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringMatches(pagerDutyRoutingKey, "a 32 character PagerDuty integration key"),
+				},
+			},
+			"severity_mapping": schema.MapAttribute{
+				Description: "PagerDuty severity per TinyMon check status. Keys: critical, warning, unknown. Values: critical, error, warning, info.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default: mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{
+					"critical": types.StringValue("critical"),
+					"warning":  types.StringValue("warning"),
+				})),
+			},
+			"auto_resolve": schema.BoolAttribute{
+				Description: "Resolve the PagerDuty incident when the check recovers.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *pagerDutyIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *pagerDutyIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config pagerDutyIntegrationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.SeverityMapping.IsNull() || config.SeverityMapping.IsUnknown() {
+		return
+	}
+
+	for status, value := range config.SeverityMapping.Elements() {
+		attrPath := path.Root("severity_mapping").AtMapKey(status)
+		if !slices.Contains(pagerDutyCheckStatuses, status) {
+			resp.Diagnostics.AddAttributeError(attrPath, "Invalid check status",
+				fmt.Sprintf("Keys must be one of: %s, got: %q", strings.Join(pagerDutyCheckStatuses, ", "), status))
+		}
+		severity, ok := value.(types.String)
+		if !ok || severity.IsNull() || severity.IsUnknown() {
+			continue
+		}
+		if !slices.Contains(pagerDutySeverities, severity.ValueString()) {
+			resp.Diagnostics.AddAttributeError(attrPath, "Invalid PagerDuty severity",
+				fmt.Sprintf("Values must be one of: %s, got: %q", strings.Join(pagerDutySeverities, ", "), severity.ValueString()))
+		}
+	}
+}
+
+func (r *pagerDutyIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pagerDutyIntegrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+	autoResolve := 1
+	if !plan.AutoResolve.IsNull() && !plan.AutoResolve.ValueBool() {
+		autoResolve = 0
+	}
+
+	severityMapping := map[string]string{}
+	resp.Diagnostics.Append(plan.SeverityMapping.ElementsAs(ctx, &severityMapping, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := pagerDutyIntegrationAPIRequest{
+		Name:            plan.Name.ValueString(),
+		RoutingKey:      plan.RoutingKey.ValueString(),
+		SeverityMapping: severityMapping,
+		AutoResolve:     autoResolve,
+		Enabled:         enabled,
+	}
+
+	var result pagerDutyIntegrationAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/integrations/pagerduty", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating PagerDuty integration", err)
+		return
+	}
+
+	mapPagerDutyIntegrationResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *pagerDutyIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pagerDutyIntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/integrations/pagerduty/%d", state.ID.ValueInt64())
+
+	var result pagerDutyIntegrationAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading PagerDuty integration", err)
+		return
+	}
+
+	mapPagerDutyIntegrationResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *pagerDutyIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pagerDutyIntegrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+	autoResolve := 1
+	if !plan.AutoResolve.IsNull() && !plan.AutoResolve.ValueBool() {
+		autoResolve = 0
+	}
+
+	severityMapping := map[string]string{}
+	resp.Diagnostics.Append(plan.SeverityMapping.ElementsAs(ctx, &severityMapping, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := pagerDutyIntegrationAPIRequest{
+		Name:            plan.Name.ValueString(),
+		RoutingKey:      plan.RoutingKey.ValueString(),
+		SeverityMapping: severityMapping,
+		AutoResolve:     autoResolve,
+		Enabled:         enabled,
+	}
+
+	apiPath := fmt.Sprintf("/api/push/integrations/pagerduty/%d", plan.ID.ValueInt64())
+
+	var result pagerDutyIntegrationAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating PagerDuty integration", err)
+		return
+	}
+
+	mapPagerDutyIntegrationResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *pagerDutyIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pagerDutyIntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/integrations/pagerduty/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting PagerDuty integration", err)
+		return
+	}
+}
+
+func (r *pagerDutyIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric integration ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func mapPagerDutyIntegrationResponseToState(apiResp *pagerDutyIntegrationAPIResponse, state *pagerDutyIntegrationResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.AutoResolve = types.BoolValue(apiResp.AutoResolve != 0)
+	state.Enabled = types.BoolValue(apiResp.Enabled != 0)
+
+	mapping := make(map[string]attr.Value, len(apiResp.SeverityMapping))
+	for status, severity := range apiResp.SeverityMapping {
+		mapping[status] = types.StringValue(severity)
+	}
+	state.SeverityMapping = types.MapValueMust(types.StringType, mapping)
+}
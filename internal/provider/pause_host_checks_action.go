@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &pauseHostChecksAction{}
+	_ action.ActionWithConfigure = &pauseHostChecksAction{}
+)
+
+func NewPauseHostChecksAction() action.Action {
+	return &pauseHostChecksAction{}
+}
+
+type pauseHostChecksAction struct {
+	client *TinyMonClient
+}
+
+type pauseHostChecksActionModel struct {
+	HostAddress types.String `tfsdk:"host_address"`
+	Resume      types.Bool   `tfsdk:"resume"`
+}
+
+func (a *pauseHostChecksAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pause_host_checks"
+}
+
+func (a *pauseHostChecksAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Disables (or, with resume, re-enables) every check of a host in one call, e.g. around maintenance of the monitored infrastructure.",
+		Attributes: map[string]schema.Attribute{
+			"host_address": schema.StringAttribute{
+				Description: "Address of the host whose checks are paused or resumed.",
+				Required:    true,
+			},
+			"resume": schema.BoolAttribute{
+				Description: "Re-enable the checks instead of disabling them. Defaults to false.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *pauseHostChecksAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	a.client = client
+}
+
+func (a *pauseHostChecksAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config pauseHostChecksActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resume := config.Resume.ValueBool()
+	verb := "Paused"
+	if resume {
+		verb = "Resumed"
+	}
+
+	address := config.HostAddress.ValueString()
+	updated, err := a.client.SetHostChecksEnabled(ctx, address, resume)
+	if err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating host checks", err)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%s %d check(s) on %s", verb, updated, address),
+	})
+}
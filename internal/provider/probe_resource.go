@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &probeResource{}
+	_ resource.ResourceWithImportState = &probeResource{}
+)
+
+// featureProbes covers /api/push/probes and the locations of checks.
+var featureProbes = serverFeature{name: "remote probes", since: serverVersion{1, 9, 0}}
+
+// probeName matches the names checks use to refer to probes in locations.
+var probeName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+func NewProbeResource() resource.Resource {
+	return &probeResource{}
+}
+
+type probeResource struct {
+	client *TinyMonClient
+}
+
+type probeResourceModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Location types.String `tfsdk:"location"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Token    types.String `tfsdk:"token"`
+}
+
+type probeAPIRequest struct {
+	Name     string `json:"name"`
+	Location string `json:"location,omitempty"`
+	Enabled  int    `json:"enabled"`
+}
+
+// probeAPIResponse only carries the token in the response to the POST that
+// registers the probe.
+type probeAPIResponse struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Location string `json:"location"`
+	Enabled  int    `json:"enabled"`
+	Token    string `json:"token"`
+}
+
+func (r *probeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_probe"
+}
+
+func (r *probeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Registers a remote probe. Checks list probe names in locations to run from them in addition to, or instead of, the server.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Probe name referenced in check locations (lowercase letters, digits and dashes). Changing this forces a new resource.",
+				Required:    true,
+				Validators: []validator.String{
+					stringMatches(probeName, "lowercase letters, digits and dashes"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location": schema.StringAttribute{
+				Description: "Human-readable location shown in the UI, e.g. \"Frankfurt, DE\".",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Disabled probes stay registered but get no checks assigned.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"token": schema.StringAttribute{
+				Description: "Token the probe authenticates with. Only returned when the probe is registered.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *probeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *probeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan probeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.client.Supports(featureProbes) {
+		resp.Diagnostics.AddError("Remote probes not supported",
+			fmt.Sprintf("TinyMon %s does not support remote probes; %s or later is required.",
+				r.client.ServerVersion(), featureProbes.since))
+		return
+	}
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+
+	body := probeAPIRequest{
+		Name:     plan.Name.ValueString(),
+		Location: plan.Location.ValueString(),
+		Enabled:  enabled,
+	}
+
+	var result probeAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/probes", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating probe", err)
+		return
+	}
+
+	mapProbeResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *probeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state probeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/probes/%d", state.ID.ValueInt64())
+
+	var result probeAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading probe", err)
+		return
+	}
+
+	mapProbeResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *probeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan probeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+
+	body := probeAPIRequest{
+		Name:     plan.Name.ValueString(),
+		Location: plan.Location.ValueString(),
+		Enabled:  enabled,
+	}
+
+	apiPath := fmt.Sprintf("/api/push/probes/%d", plan.ID.ValueInt64())
+
+	var result probeAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating probe", err)
+		return
+	}
+
+	mapProbeResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *probeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state probeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/probes/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting probe", err)
+		return
+	}
+}
+
+func (r *probeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric probe ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func mapProbeResponseToState(apiResp *probeAPIResponse, state *probeResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.Location = stringOrNull(apiResp.Location)
+	state.Enabled = types.BoolValue(apiResp.Enabled != 0)
+
+	// The token is only sent once; keep it from state afterwards. Imported
+	// probes have no token in state.
+	if apiResp.Token != "" {
+		state.Token = types.StringValue(apiResp.Token)
+	} else if state.Token.IsNull() || state.Token.IsUnknown() {
+		state.Token = types.StringNull()
+	}
+}
@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &prometheusIntegrationResource{}
+	_ resource.ResourceWithImportState    = &prometheusIntegrationResource{}
+	_ resource.ResourceWithValidateConfig = &prometheusIntegrationResource{}
+)
+
+// prometheusLabelName matches Prometheus label names. Names starting with
+// "__" are reserved for Prometheus itself.
+var prometheusLabelName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func NewPrometheusIntegrationResource() resource.Resource {
+	return &prometheusIntegrationResource{}
+}
+
+type prometheusIntegrationResource struct {
+	client *TinyMonClient
+}
+
+type prometheusIntegrationResourceModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Mode           types.String `tfsdk:"mode"`
+	RemoteWriteURL types.String `tfsdk:"remote_write_url"`
+	ScrapeInterval types.String `tfsdk:"scrape_interval"`
+	Labels         types.Map    `tfsdk:"labels"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	BearerToken    types.String `tfsdk:"bearer_token"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	ScrapeURL      types.String `tfsdk:"scrape_url"`
+}
+
+type prometheusIntegrationAPIRequest struct {
+	Name           string            `json:"name"`
+	Mode           string            `json:"mode"`
+	RemoteWriteURL string            `json:"remote_write_url,omitempty"`
+	ScrapeInterval string            `json:"scrape_interval"`
+	Labels         map[string]string `json:"labels"`
+	Username       string            `json:"username,omitempty"`
+	Password       string            `json:"password,omitempty"`
+	BearerToken    string            `json:"bearer_token,omitempty"`
+	Enabled        int               `json:"enabled"`
+}
+
+// prometheusIntegrationAPIResponse never includes the password and bearer
+// token; they are write-only on the server and kept from the configuration.
+type prometheusIntegrationAPIResponse struct {
+	ID             int64             `json:"id"`
+	Name           string            `json:"name"`
+	Mode           string            `json:"mode"`
+	RemoteWriteURL string            `json:"remote_write_url"`
+	ScrapeInterval string            `json:"scrape_interval"`
+	Labels         map[string]string `json:"labels"`
+	Username       string            `json:"username"`
+	Enabled        int               `json:"enabled"`
+	ScrapeURL      string            `json:"scrape_url"`
+}
+
+func (r *prometheusIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prometheus_integration"
+}
+
+func (r *prometheusIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Prometheus integration. TinyMon either exposes check results on an endpoint Prometheus scrapes, or pushes them to a Prometheus remote-write endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the integration.",
+				Required:    true,
+			},
+			"mode": schema.StringAttribute{
+				Description: "scrape exposes metrics at scrape_url, remote_write pushes them to remote_write_url. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringOneOf("scrape", "remote_write"),
+				},
+			},
+			"remote_write_url": schema.StringAttribute{
+				Description: "Remote-write endpoint, e.g. https://prometheus.example.com/api/v1/write. Required in remote_write mode.",
+				Optional:    true,
+			},
+			"scrape_interval": schema.StringAttribute{
+				Description: "In scrape mode, the interval Prometheus scrapes at; exported values are refreshed as often. In remote_write mode, how often samples are pushed.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("60s"),
+				Validators: []validator.String{
+					duration(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels added to every exported series, e.g. { source = \"tinymon\" }.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Basic auth user name. In scrape mode Prometheus must send it, in remote_write mode TinyMon sends it.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Basic auth password. Not returned by the server, so changes made outside Terraform are not detected.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"bearer_token": schema.StringAttribute{
+				Description: "Bearer token used instead of basic auth. Not returned by the server, so changes made outside Terraform are not detected.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"scrape_url": schema.StringAttribute{
+				Description: "URL Prometheus scrapes in scrape mode. Null in remote_write mode.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *prometheusIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *prometheusIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config prometheusIntegrationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Mode.IsUnknown() {
+		remoteWrite := config.Mode.ValueString() == "remote_write"
+		if remoteWrite && config.RemoteWriteURL.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("remote_write_url"), "Missing required argument",
+				"remote_write_url is required when mode is \"remote_write\".")
+		}
+		if !remoteWrite && !config.RemoteWriteURL.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("remote_write_url"), "Invalid attribute combination",
+				"remote_write_url can only be set when mode is \"remote_write\".")
+		}
+	}
+	if !config.RemoteWriteURL.IsNull() && !config.RemoteWriteURL.IsUnknown() {
+		parsed, err := url.Parse(config.RemoteWriteURL.ValueString())
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("remote_write_url"), "Invalid URL",
+				fmt.Sprintf("remote_write_url must be an absolute http or https URL, got %q.", config.RemoteWriteURL.ValueString()))
+		}
+	}
+
+	if !config.BearerToken.IsNull() && (!config.Username.IsNull() || !config.Password.IsNull()) {
+		resp.Diagnostics.AddAttributeError(path.Root("bearer_token"), "Invalid attribute combination",
+			"bearer_token cannot be combined with username and password.")
+	}
+	if !config.Password.IsNull() && config.Username.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("password"), "Missing username",
+			"password is the basic auth password and needs username.")
+	}
+
+	if config.Labels.IsNull() || config.Labels.IsUnknown() {
+		return
+	}
+	for name := range config.Labels.Elements() {
+		if !prometheusLabelName.MatchString(name) || strings.HasPrefix(name, "__") {
+			resp.Diagnostics.AddAttributeError(path.Root("labels").AtMapKey(name), "Invalid label name",
+				fmt.Sprintf("Label names must match %s and must not start with \"__\", got: %q", prometheusLabelName, name))
+		}
+	}
+}
+
+func (r *prometheusIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan prometheusIntegrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := prometheusIntegrationToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result prometheusIntegrationAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/integrations/prometheus", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating Prometheus integration", err)
+		return
+	}
+
+	mapPrometheusIntegrationResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *prometheusIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state prometheusIntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/integrations/prometheus/%d", state.ID.ValueInt64())
+
+	var result prometheusIntegrationAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading Prometheus integration", err)
+		return
+	}
+
+	mapPrometheusIntegrationResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *prometheusIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan prometheusIntegrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := prometheusIntegrationToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/integrations/prometheus/%d", plan.ID.ValueInt64())
+
+	var result prometheusIntegrationAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating Prometheus integration", err)
+		return
+	}
+
+	mapPrometheusIntegrationResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *prometheusIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state prometheusIntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/integrations/prometheus/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting Prometheus integration", err)
+		return
+	}
+}
+
+func (r *prometheusIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric integration ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func prometheusIntegrationToAPI(ctx context.Context, plan *prometheusIntegrationResourceModel) (prometheusIntegrationAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+
+	labels := map[string]string{}
+	if !plan.Labels.IsNull() {
+		diags.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+	}
+
+	body := prometheusIntegrationAPIRequest{
+		Name:           plan.Name.ValueString(),
+		Mode:           plan.Mode.ValueString(),
+		RemoteWriteURL: plan.RemoteWriteURL.ValueString(),
+		ScrapeInterval: plan.ScrapeInterval.ValueString(),
+		Labels:         labels,
+		Username:       plan.Username.ValueString(),
+		Password:       plan.Password.ValueString(),
+		BearerToken:    plan.BearerToken.ValueString(),
+		Enabled:        enabled,
+	}
+	return body, diags
+}
+
+func mapPrometheusIntegrationResponseToState(apiResp *prometheusIntegrationAPIResponse, state *prometheusIntegrationResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.Mode = types.StringValue(apiResp.Mode)
+	state.RemoteWriteURL = stringOrNull(apiResp.RemoteWriteURL)
+	state.ScrapeInterval = types.StringValue(apiResp.ScrapeInterval)
+	state.Labels = stringMapToState(apiResp.Labels, state.Labels)
+	state.Username = stringOrNull(apiResp.Username)
+	state.Enabled = types.BoolValue(apiResp.Enabled != 0)
+	state.ScrapeURL = stringOrNull(apiResp.ScrapeURL)
+}
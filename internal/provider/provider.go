@@ -1,84 +1,174 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
+	"crypto/tls"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
+	neturl "net/url"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
 )
 
-var _ provider.Provider = &tinymonProvider{}
+var (
+	_ provider.Provider                       = &tinymonProvider{}
+	_ provider.ProviderWithFunctions          = &tinymonProvider{}
+	_ provider.ProviderWithEphemeralResources = &tinymonProvider{}
+	_ provider.ProviderWithListResources      = &tinymonProvider{}
+	_ provider.ProviderWithActions            = &tinymonProvider{}
+)
 
-type TinyMonClient struct {
-	URL    string
-	APIKey string
-	HTTP   *http.Client
+// Client is the TinyMon API used by resources, data sources and actions.
+// *tinymon.Client implements it; tests can substitute a fake, e.g. a
+// tinymon.Client pointed at a tinymontest server.
+type Client interface {
+	DoJSON(ctx context.Context, method, path string, body interface{}, result interface{}) error
+	Ping(ctx context.Context) error
+
+	UpsertHost(ctx context.Context, host tinymon.HostRequest) (*tinymon.Host, error)
+	GetHost(ctx context.Context, id int64) (*tinymon.Host, error)
+	GetHostByAddress(ctx context.Context, address string) (*tinymon.Host, error)
+	ListHosts(ctx context.Context, opts tinymon.ListHostsOptions) ([]tinymon.Host, error)
+	DeleteHost(ctx context.Context, id int64) error
+	DeleteHostByAddress(ctx context.Context, address string) error
+
+	UpsertCheck(ctx context.Context, check tinymon.CheckRequest) (*tinymon.Check, error)
+	UpsertChecks(ctx context.Context, checks []tinymon.CheckRequest) ([]tinymon.CheckBatchResult, error)
+	GetCheck(ctx context.Context, id int64) (*tinymon.Check, error)
+	GetCheckByKey(ctx context.Context, key tinymon.CheckKey) (*tinymon.Check, error)
+	ListChecks(ctx context.Context, opts tinymon.ListChecksOptions) ([]tinymon.Check, error)
+	DeleteCheck(ctx context.Context, id int64) error
+	DeleteCheckByKey(ctx context.Context, key tinymon.CheckKey) error
+	GetCheckResult(ctx context.Context, id int64) (*tinymon.CheckResult, error)
+	RunCheck(ctx context.Context, id int64) error
+	SetHostChecksEnabled(ctx context.Context, address string, enabled bool) (int64, error)
 }
 
-func (c *TinyMonClient) DoJSON(method, path string, body interface{}, result interface{}) error {
-	url := strings.TrimRight(c.URL, "/") + path
+var _ Client = (*tinymon.Client)(nil)
 
-	var reqBody io.Reader
-	if body != nil {
-		data, err := json.Marshal(body)
-		if err != nil {
-			return fmt.Errorf("marshalling request body: %w", err)
-		}
-		reqBody = bytes.NewReader(data)
-	}
+// TinyMonClient is the API client handed to resources, data sources and
+// actions. It adds the provider's server discovery, check batching and
+// settings to a Client.
+type TinyMonClient struct {
+	Client
 
-	req, err := http.NewRequest(method, url, reqBody)
-	if err != nil {
-		return fmt.Errorf("creating request: %w", err)
-	}
+	// checkTypesMu guards checkTypes, which is valid once checkTypesFetched
+	// is set.
+	checkTypesMu      sync.Mutex
+	checkTypesFetched bool
+	checkTypes        []string
 
-	req.Header.Set("Authorization", "Bearer "+c.APIKey)
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
-	}
-	req.Header.Set("Accept", "application/json")
+	// limitsMu guards limits, which is valid once limitsFetched is set.
+	limitsMu      sync.Mutex
+	limitsFetched bool
+	limits        *ServerLimits
 
-	resp, err := c.HTTP.Do(req)
-	if err != nil {
-		return fmt.Errorf("executing request %s %s: %w", method, path, err)
-	}
-	defer resp.Body.Close()
+	// version is set by DetectVersion during Configure. Nil means unknown.
+	version *serverVersion
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("reading response body: %w", err)
+	batcherOnce sync.Once
+	batcher     *checkBatcher
+
+	// onConflict is the provider-wide on_conflict mode. Empty means adopt.
+	onConflict string
+}
+
+// CheckTypes returns the check types supported by the server. It returns nil
+// without an error when the server does not expose check type discovery. The
+// result is fetched once per client; failed requests are retried on the next
+// call.
+func (c *TinyMonClient) CheckTypes(ctx context.Context) ([]string, error) {
+	c.checkTypesMu.Lock()
+	defer c.checkTypesMu.Unlock()
+	if c.checkTypesFetched {
+		return c.checkTypes, nil
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("API %s %s returned status %d: %s", method, path, resp.StatusCode, string(respBody))
+	var supported []string
+	err := c.DoJSON(ctx, "GET", "/api/push/check_types", nil, &supported)
+	if err != nil && !isNotFound(err) {
+		return nil, err
 	}
+	c.checkTypes, c.checkTypesFetched = supported, true
+	return c.checkTypes, nil
+}
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("unmarshalling response: %w", err)
-		}
+// ServerLimits are instance limits reported by /api/push/limits.
+type ServerLimits struct {
+	MinIntervalSeconds int64 `json:"min_interval_seconds"`
+	MaxChecks          int64 `json:"max_checks"`
+}
+
+// Limits returns the server's limits, or nil without an error when the server
+// does not expose them. The result is fetched once per client; failed
+// requests are retried on the next call.
+func (c *TinyMonClient) Limits(ctx context.Context) (*ServerLimits, error) {
+	c.limitsMu.Lock()
+	defer c.limitsMu.Unlock()
+	if c.limitsFetched {
+		return c.limits, nil
 	}
 
-	return nil
+	var limits ServerLimits
+	err := c.DoJSON(ctx, "GET", "/api/push/limits", nil, &limits)
+	switch {
+	case isNotFound(err):
+		c.limits = nil
+	case err != nil:
+		return nil, err
+	default:
+		c.limits = &limits
+	}
+	c.limitsFetched = true
+	return c.limits, nil
 }
 
+// maxIdleConnsPerHost bounds the connection pool to the TinyMon server. It is
+// well above Terraform's default parallelism of 10.
+const maxIdleConnsPerHost = 32
+
 type tinymonProvider struct {
 	version string
 }
 
 type tinymonProviderModel struct {
-	URL    types.String `tfsdk:"url"`
-	APIKey types.String `tfsdk:"api_key"`
+	URL                       types.String  `tfsdk:"url"`
+	APIKey                    types.String  `tfsdk:"api_key"`
+	APIKeyFile                types.String  `tfsdk:"api_key_file"`
+	APIKeySecondary           types.String  `tfsdk:"api_key_secondary"`
+	Username                  types.String  `tfsdk:"username"`
+	Password                  types.String  `tfsdk:"password"`
+	ClientCertPEM             types.String  `tfsdk:"client_cert_pem"`
+	ClientKeyPEM              types.String  `tfsdk:"client_key_pem"`
+	ClientCertFile            types.String  `tfsdk:"client_cert_file"`
+	ClientKeyFile             types.String  `tfsdk:"client_key_file"`
+	Headers                   types.Map     `tfsdk:"headers"`
+	RequestsPerSecond         types.Float64 `tfsdk:"requests_per_second"`
+	ProxyURL                  types.String  `tfsdk:"proxy_url"`
+	SkipCredentialsValidation types.Bool    `tfsdk:"skip_credentials_validation"`
+	DisableReadCache          types.Bool    `tfsdk:"disable_read_cache"`
+	OnConflict                types.String  `tfsdk:"on_conflict"`
+	Organization              types.String  `tfsdk:"organization"`
 }
 
 func New(version string) func() provider.Provider {
@@ -107,6 +197,74 @@ func (p *tinymonProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"api_key_file": schema.StringAttribute{
+				Description: "Path to a file containing the API key, e.g. one mounted by a secrets manager. It is read at configuration time and surrounding whitespace is ignored. Conflicts with api_key. Can also be set via TINYMON_API_KEY_FILE environment variable.",
+				Optional:    true,
+			},
+			"api_key_secondary": schema.StringAttribute{
+				Description: "Fallback API key used once the server rejects api_key with 401, for rotating keys without failing a running apply. Can also be set via TINYMON_API_KEY_SECONDARY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username for HTTP Basic auth, for deployments behind proxies that only pass Basic auth. Requires password; conflicts with api_key. Can also be set via TINYMON_USERNAME environment variable.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password for HTTP Basic auth. Can also be set via TINYMON_PASSWORD environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded client certificate for mutual TLS. Requires client_key_pem or client_key_file. Can also be set via TINYMON_CLIENT_CERT_PEM environment variable.",
+				Optional:    true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM-encoded private key for the client certificate. Can also be set via TINYMON_CLIENT_KEY_PEM environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM file containing the client certificate, read at configuration time. Conflicts with client_cert_pem. Can also be set via TINYMON_CLIENT_CERT_FILE environment variable.",
+				Optional:    true,
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: "Path to a PEM file containing the private key for the client certificate, read at configuration time. Conflicts with client_key_pem. Can also be set via TINYMON_CLIENT_KEY_FILE environment variable.",
+				Optional:    true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "Additional HTTP headers sent with every API request, e.g. for proxies or Cloudflare Access. Cannot override the Authorization header.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of API requests per second. 0 or unset means unlimited. Can also be set via TINYMON_REQUESTS_PER_SECOND environment variable.",
+				Optional:    true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Description: "Skip the connectivity and API key check during provider configuration, e.g. for air-gapped planning. Can also be set via TINYMON_SKIP_CREDENTIALS_VALIDATION environment variable.",
+				Optional:    true,
+			},
+			"disable_read_cache": schema.BoolAttribute{
+				Description: "Disable the short-lived cache of GET responses that deduplicates identical lookups during a refresh. Can also be set via TINYMON_DISABLE_READ_CACHE environment variable.",
+				Optional:    true,
+			},
+			"on_conflict": schema.StringAttribute{
+				Description: "What creating a host or check does when it already exists: error, adopt (update it in place, the default) or overwrite (delete and recreate). Resources can override it. Can also be set via TINYMON_ON_CONFLICT environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf(onConflictModes...),
+				},
+			},
+			"organization": schema.StringAttribute{
+				Description: "Organization (slug) all API requests are scoped to, for TinyMon servers with multiple organizations. Defaults to the API key's default organization. Hosts and checks can override it. Can also be set via TINYMON_ORGANIZATION environment variable.",
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "Forward proxy for all API requests, e.g. http://proxy:3128. Overrides HTTP_PROXY/HTTPS_PROXY. Can also be set via TINYMON_PROXY_URL environment variable.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -130,38 +288,360 @@ func (p *tinymonProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	// api_key wins over api_key_file, and configuration over the
+	// environment.
 	apiKey := os.Getenv("TINYMON_API_KEY")
+	var apiKeyFile string
+	if apiKey == "" {
+		apiKeyFile = os.Getenv("TINYMON_API_KEY_FILE")
+	}
+	if !config.APIKeyFile.IsNull() && !config.APIKeyFile.IsUnknown() {
+		apiKey, apiKeyFile = "", config.APIKeyFile.ValueString()
+	}
 	if !config.APIKey.IsNull() && !config.APIKey.IsUnknown() {
-		apiKey = config.APIKey.ValueString()
+		if !config.APIKeyFile.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("api_key_file"), "Conflicting TinyMon API Key",
+				"Set either api_key or api_key_file, not both.")
+		}
+		apiKey, apiKeyFile = config.APIKey.ValueString(), ""
 	}
-	if apiKey == "" {
+	if apiKeyFile != "" {
+		data, err := os.ReadFile(apiKeyFile)
+		switch {
+		case err != nil:
+			resp.Diagnostics.AddAttributeError(path.Root("api_key_file"), "Unable to read TinyMon API Key file", err.Error())
+		case strings.TrimSpace(string(data)) == "":
+			resp.Diagnostics.AddAttributeError(path.Root("api_key_file"), "Empty TinyMon API Key file",
+				fmt.Sprintf("%s does not contain an API key.", apiKeyFile))
+		default:
+			apiKey = strings.TrimSpace(string(data))
+		}
+	}
+
+	apiKeySecondary := os.Getenv("TINYMON_API_KEY_SECONDARY")
+	if !config.APIKeySecondary.IsNull() && !config.APIKeySecondary.IsUnknown() {
+		apiKeySecondary = config.APIKeySecondary.ValueString()
+	}
+
+	username := os.Getenv("TINYMON_USERNAME")
+	if !config.Username.IsNull() && !config.Username.IsUnknown() {
+		username = config.Username.ValueString()
+	}
+	password := os.Getenv("TINYMON_PASSWORD")
+	if !config.Password.IsNull() && !config.Password.IsUnknown() {
+		password = config.Password.ValueString()
+	}
+
+	switch {
+	case username != "" && (apiKey != "" || apiKeySecondary != ""):
+		resp.Diagnostics.AddError(
+			"Conflicting TinyMon credentials",
+			"Set either api_key or username/password, not both. Check the TINYMON_API_KEY and TINYMON_USERNAME environment variables as well.",
+		)
+	case username != "" && password == "":
+		resp.Diagnostics.AddError(
+			"Missing TinyMon password",
+			"username requires password. Set password in the provider configuration or via the TINYMON_PASSWORD environment variable.",
+		)
+	case username == "" && password != "":
+		resp.Diagnostics.AddError(
+			"Missing TinyMon username",
+			"password requires username. Set username in the provider configuration or via the TINYMON_USERNAME environment variable.",
+		)
+	case username == "" && apiKey == "" && apiKeyFile == "":
 		resp.Diagnostics.AddError(
 			"Missing TinyMon API Key",
-			"Set api_key in the provider configuration or via the TINYMON_API_KEY environment variable.",
+			"Set api_key or api_key_file in the provider configuration or via the TINYMON_API_KEY or TINYMON_API_KEY_FILE environment variable, or use username and password for Basic auth.",
+		)
+	}
+
+	var certDiags diag.Diagnostics
+	clientCertPEM := clientPEM(config.ClientCertPEM, config.ClientCertFile, "client_cert", "TINYMON_CLIENT_CERT", &certDiags)
+	clientKeyPEM := clientPEM(config.ClientKeyPEM, config.ClientKeyFile, "client_key", "TINYMON_CLIENT_KEY", &certDiags)
+	if !certDiags.HasError() && (clientCertPEM == "") != (clientKeyPEM == "") {
+		certDiags.AddError(
+			"Incomplete client certificate",
+			"The client certificate and key must be set together, each via its _pem or _file attribute.",
+		)
+	}
+	resp.Diagnostics.Append(certDiags...)
+
+	headers := map[string]string{}
+	if !config.Headers.IsNull() && !config.Headers.IsUnknown() {
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+	}
+
+	proxyURL := os.Getenv("TINYMON_PROXY_URL")
+	if !config.ProxyURL.IsNull() && !config.ProxyURL.IsUnknown() {
+		proxyURL = config.ProxyURL.ValueString()
+	}
+
+	var requestsPerSecond float64
+	if v := os.Getenv("TINYMON_REQUESTS_PER_SECOND"); v != "" {
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid TINYMON_REQUESTS_PER_SECOND",
+				fmt.Sprintf("Expected a number, got %q.", v),
+			)
+		}
+		requestsPerSecond = parsed
+	}
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		requestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	}
+	if requestsPerSecond < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid requests_per_second",
+			"requests_per_second must not be negative.",
 		)
 	}
 
+	onConflict := os.Getenv("TINYMON_ON_CONFLICT")
+	if onConflict != "" && !slices.Contains(onConflictModes, onConflict) {
+		resp.Diagnostics.AddError(
+			"Invalid TINYMON_ON_CONFLICT",
+			fmt.Sprintf("Expected one of %s, got %q.", strings.Join(onConflictModes, ", "), onConflict),
+		)
+	}
+	if !config.OnConflict.IsNull() && !config.OnConflict.IsUnknown() {
+		onConflict = config.OnConflict.ValueString()
+	}
+
+	organization := os.Getenv("TINYMON_ORGANIZATION")
+	if !config.Organization.IsNull() && !config.Organization.IsUnknown() {
+		organization = config.Organization.ValueString()
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The cloned default transport honors HTTP_PROXY, HTTPS_PROXY and NO_PROXY.
+	// All requests go to one host, so keep enough idle connections for
+	// Terraform's parallel operations; the default of two per host makes
+	// parallel plans open and close connections until ports run out.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = maxIdleConnsPerHost
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	transport.IdleConnTimeout = 90 * time.Second
+	transport.TLSHandshakeTimeout = 10 * time.Second
+	// A custom TLSClientConfig (mTLS) disables HTTP/2 unless forced.
+	transport.ForceAttemptHTTP2 = true
+	if proxyURL != "" {
+		parsed, err := neturl.Parse(proxyURL)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("proxy_url"), "Invalid proxy_url",
+				fmt.Sprintf("Expected an absolute URL such as http://proxy:3128, got %q.", proxyURL))
+			return
+		}
+		transport.Proxy = http.ProxyURL(parsed)
+	}
+	if clientCertPEM != "" {
+		cert, err := tls.X509KeyPair([]byte(clientCertPEM), []byte(clientKeyPEM))
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid client certificate", err.Error())
+			return
+		}
+		transport.TLSClientConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+	httpClient := &http.Client{Transport: transport}
+
+	var disableReadCache bool
+	if v := os.Getenv("TINYMON_DISABLE_READ_CACHE"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("disable_read_cache"), "Invalid TINYMON_DISABLE_READ_CACHE",
+				fmt.Sprintf("Expected true or false, got %q.", v))
+			return
+		}
+		disableReadCache = parsed
+	}
+	if !config.DisableReadCache.IsNull() && !config.DisableReadCache.IsUnknown() {
+		disableReadCache = config.DisableReadCache.ValueBool()
+	}
+
 	client := &TinyMonClient{
-		URL:    url,
-		APIKey: apiKey,
-		HTTP:   &http.Client{},
+		Client: tinymon.New(tinymon.Config{
+			URL:               url,
+			APIKey:            apiKey,
+			APIKeySecondary:   apiKeySecondary,
+			Username:          username,
+			Password:          password,
+			Headers:           headers,
+			HTTPClient:        httpClient,
+			Organization:      organization,
+			RequestsPerSecond: requestsPerSecond,
+			ReadCache:         !disableReadCache,
+		}),
+		onConflict: onConflict,
+	}
+
+	var skipValidation bool
+	if v := os.Getenv("TINYMON_SKIP_CREDENTIALS_VALIDATION"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("skip_credentials_validation"), "Invalid TINYMON_SKIP_CREDENTIALS_VALIDATION",
+				fmt.Sprintf("Expected true or false, got %q.", v))
+			return
+		}
+		skipValidation = parsed
+	}
+	if !config.SkipCredentialsValidation.IsNull() && !config.SkipCredentialsValidation.IsUnknown() {
+		skipValidation = config.SkipCredentialsValidation.ValueBool()
+	}
+	if !skipValidation {
+		if err := client.Ping(ctx); err != nil {
+			var apiErr *tinymon.APIError
+			if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden) {
+				if username != "" {
+					resp.Diagnostics.AddError("Invalid TinyMon credentials",
+						fmt.Sprintf("The server at %s rejected the username or password: %s", url, err))
+					return
+				}
+				resp.Diagnostics.AddError("Invalid TinyMon API Key",
+					fmt.Sprintf("The server at %s rejected the API key: %s", url, err))
+				return
+			}
+			resp.Diagnostics.AddError("Unable to connect to TinyMon",
+				fmt.Sprintf("Could not reach %s: %s\n\nCheck url and network access, or set skip_credentials_validation = true.", url, err))
+			return
+		}
+
+		if err := client.DetectVersion(ctx); err != nil {
+			resp.Diagnostics.AddWarning("Unable to detect TinyMon version",
+				fmt.Sprintf("Assuming a current server: %s", err))
+		} else if client.version.less(minServerVersion) {
+			resp.Diagnostics.AddWarning("Unsupported TinyMon version",
+				fmt.Sprintf("The server at %s reports version %s, but this provider supports %s and newer. "+
+					"Some features fall back to legacy endpoints or are unavailable; upgrade TinyMon to avoid surprises.",
+					url, client.ServerVersion(), minServerVersion))
+		}
+
+		// Older servers ignore the organization header, so every request
+		// would silently land in the default organization.
+		if organization != "" && !client.Supports(featureOrganizations) {
+			resp.Diagnostics.AddAttributeError(path.Root("organization"), "Organizations not supported",
+				fmt.Sprintf("TinyMon %s does not support organizations; %s or later is required for organization.",
+					client.ServerVersion(), featureOrganizations.since))
+			return
+		}
 	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
+	resp.ActionData = client
+}
+
+// clientPEM returns the client certificate or key from the <name>_pem or
+// <name>_file attribute or their environment variables. Like api_key and
+// api_key_file, the inline value wins over the file and configuration over
+// the environment.
+func clientPEM(value, file types.String, name, env string, diags *diag.Diagnostics) string {
+	pem := os.Getenv(env + "_PEM")
+	var filename string
+	if pem == "" {
+		filename = os.Getenv(env + "_FILE")
+	}
+	if !file.IsNull() && !file.IsUnknown() {
+		pem, filename = "", file.ValueString()
+	}
+	if !value.IsNull() && !value.IsUnknown() {
+		if !file.IsNull() {
+			diags.AddAttributeError(path.Root(name+"_file"), "Conflicting client certificate settings",
+				fmt.Sprintf("Set either %s_pem or %s_file, not both.", name, name))
+		}
+		pem, filename = value.ValueString(), ""
+	}
+	if filename == "" {
+		return pem
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		diags.AddAttributeError(path.Root(name+"_file"), "Unable to read client certificate file", err.Error())
+		return ""
+	}
+	return string(data)
+}
+
+func (p *tinymonProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewRunCheckAction,
+		NewAcknowledgeAlertsAction,
+		NewPauseHostChecksAction,
+	}
+}
+
+func (p *tinymonProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewHostListResource,
+		NewCheckListResource,
+	}
 }
 
 func (p *tinymonProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewHostResource,
 		NewCheckResource,
+		NewHeartbeatResource,
+		NewUserResource,
+		NewDashboardResource,
+		NewWebhookResource,
+		NewNotificationChannelResource,
+		NewPagerDutyIntegrationResource,
+		NewEmailSettingsResource,
+		NewGlobalSettingsResource,
+		NewReportResource,
+		NewProbeResource,
+		NewTagResource,
+		NewContactResource,
+		NewContactGroupResource,
+		NewStatusPageIncidentResource,
+		NewStatusPageComponentResource,
+		NewSLOResource,
+		NewDowntimeResource,
+		NewMaintenanceWindowResource,
+		NewMuteRuleResource,
+		NewAlertRoutingRuleResource,
+		NewNotificationTemplateResource,
+		NewCheckTemplateResource,
+		NewAgentResource,
+		NewPrometheusIntegrationResource,
+		NewSyntheticCheckResource,
 	}
 }
 
 func (p *tinymonProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewChecksDataSource,
+		NewUptimeDataSource,
+		NewCheckResultDataSource,
+		NewTopicsDataSource,
+		NewServerInfoDataSource,
+		NewActiveAlertsDataSource,
+		NewMetricsDataSource,
+		NewEventsDataSource,
+		NewUsersDataSource,
+		NewTeamsDataSource,
+	}
+}
+
+func (p *tinymonProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAPITokenEphemeralResource,
+		NewAgentTokenEphemeralResource,
+	}
+}
+
+func (p *tinymonProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewHTTPCheckConfigFunction,
+	}
 }
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon/tinymontest"
+)
+
+// newTestClient starts a fake TinyMon server and returns it with a provider
+// client for it. The server is closed when the test ends.
+func newTestClient(t *testing.T) (*tinymontest.Server, *TinyMonClient) {
+	t.Helper()
+	srv := tinymontest.NewServer()
+	t.Cleanup(srv.Close)
+	return srv, &TinyMonClient{Client: srv.Client()}
+}
+
+// resourceHarness calls a resource's CRUD methods the way the framework does,
+// without Terraform. Plans are taken verbatim from the given attributes, so
+// plan modifiers and defaults do not run.
+type resourceHarness struct {
+	t        *testing.T
+	r        resource.Resource
+	schema   resource.SchemaResponse
+	identity resource.IdentitySchemaResponse
+}
+
+func newResourceHarness(t *testing.T, r resource.Resource) *resourceHarness {
+	t.Helper()
+	ctx := context.Background()
+	h := &resourceHarness{t: t, r: r}
+	r.Schema(ctx, resource.SchemaRequest{}, &h.schema)
+	if r, ok := r.(resource.ResourceWithIdentity); ok {
+		r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &h.identity)
+	}
+	h.check(h.schema.Diagnostics)
+	return h
+}
+
+// value builds an object of the resource's schema. Attributes and blocks not
+// in attrs are null.
+func (h *resourceHarness) value(attrs map[string]tftypes.Value) tftypes.Value {
+	typ := h.schema.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range attrs {
+		if _, ok := typ.AttributeTypes[name]; !ok {
+			h.t.Fatalf("attribute %q is not in the schema", name)
+		}
+		values[name] = value
+	}
+	return tftypes.NewValue(typ, values)
+}
+
+func (h *resourceHarness) create(attrs map[string]tftypes.Value) tfsdk.State {
+	h.t.Helper()
+	ctx := context.Background()
+	v := h.value(attrs)
+	resp := resource.CreateResponse{State: h.nullState(), Identity: h.newIdentity()}
+	h.r.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: h.schema.Schema, Raw: v},
+		Plan:   tfsdk.Plan{Schema: h.schema.Schema, Raw: v},
+	}, &resp)
+	h.check(resp.Diagnostics)
+	return resp.State
+}
+
+// read refreshes state. It returns false when the resource was removed.
+func (h *resourceHarness) read(state tfsdk.State) (tfsdk.State, bool) {
+	h.t.Helper()
+	resp := resource.ReadResponse{State: state, Identity: h.newIdentity()}
+	h.r.Read(context.Background(), resource.ReadRequest{State: state, Identity: h.newIdentity()}, &resp)
+	h.check(resp.Diagnostics)
+	return resp.State, !resp.State.Raw.IsNull()
+}
+
+// update applies attrs to the resource in state. The id is carried over
+// from state.
+func (h *resourceHarness) update(state tfsdk.State, attrs map[string]tftypes.Value) tfsdk.State {
+	h.t.Helper()
+	ctx := context.Background()
+	prior := map[string]tftypes.Value{}
+	if err := state.Raw.As(&prior); err != nil {
+		h.t.Fatalf("reading state: %s", err)
+	}
+	withID := map[string]tftypes.Value{"id": prior["id"]}
+	for name, value := range attrs {
+		withID[name] = value
+	}
+	v := h.value(withID)
+	resp := resource.UpdateResponse{State: state, Identity: h.newIdentity()}
+	h.r.Update(ctx, resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: h.schema.Schema, Raw: v},
+		Plan:   tfsdk.Plan{Schema: h.schema.Schema, Raw: v},
+		State:  state,
+	}, &resp)
+	h.check(resp.Diagnostics)
+	return resp.State
+}
+
+func (h *resourceHarness) delete(state tfsdk.State) {
+	h.t.Helper()
+	resp := resource.DeleteResponse{State: state}
+	h.r.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+	h.check(resp.Diagnostics)
+}
+
+func (h *resourceHarness) nullState() tfsdk.State {
+	typ := h.schema.Schema.Type().TerraformType(context.Background())
+	return tfsdk.State{Schema: h.schema.Schema, Raw: tftypes.NewValue(typ, nil)}
+}
+
+func (h *resourceHarness) newIdentity() *tfsdk.ResourceIdentity {
+	if h.identity.IdentitySchema.Attributes == nil {
+		return nil
+	}
+	typ := h.identity.IdentitySchema.Type().TerraformType(context.Background())
+	return &tfsdk.ResourceIdentity{Schema: h.identity.IdentitySchema, Raw: tftypes.NewValue(typ, nil)}
+}
+
+func (h *resourceHarness) check(diags diag.Diagnostics) {
+	h.t.Helper()
+	if diags.HasError() {
+		h.t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+// getAttribute reads the attribute name from state into target.
+func getAttribute(t *testing.T, state tfsdk.State, name string, target interface{}) {
+	t.Helper()
+	if diags := state.GetAttribute(context.Background(), path.Root(name), target); diags.HasError() {
+		t.Fatalf("reading %s: %v", name, diags)
+	}
+}
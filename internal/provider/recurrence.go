@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// cronField describes one field of a five-field cron expression. names maps
+// symbolic values such as JAN or SUN to their numbers.
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}},
+	// 7 is Sunday as well, as in most cron implementations.
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}},
+}
+
+// parseCron checks a five-field cron expression (minute hour day-of-month
+// month day-of-week). Fields accept *, numbers, names, ranges, lists and
+// steps, e.g. "0 2 * * SUN" or "30 1 1-7 * MON-FRI".
+func parseCron(expr string) error {
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(fields))
+	}
+	for i, field := range fields {
+		if err := cronFields[i].parse(field); err != nil {
+			return fmt.Errorf("%s: %w", cronFields[i].name, err)
+		}
+	}
+	return nil
+}
+
+func (f cronField) parse(field string) error {
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			step, err := strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return fmt.Errorf("invalid step %q", stepPart)
+			}
+		}
+		if rangePart == "*" {
+			continue
+		}
+		low, high, isRange := strings.Cut(rangePart, "-")
+		first, err := f.value(low)
+		if err != nil {
+			return err
+		}
+		if !isRange {
+			continue
+		}
+		last, err := f.value(high)
+		if err != nil {
+			return err
+		}
+		if last < first {
+			return fmt.Errorf("range %q ends before it starts", rangePart)
+		}
+	}
+	return nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if n, ok := f.names[strings.ToUpper(s)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("%q is not a value between %d and %d", s, f.min, f.max)
+	}
+	return n, nil
+}
+
+var rruleWeekdays = []string{"MO", "TU", "WE", "TH", "FR", "SA", "SU"}
+
+// parseRRule checks an RFC 5545 recurrence rule such as
+// "FREQ=WEEKLY;BYDAY=SU;BYHOUR=2;BYMINUTE=0", without the "RRULE:" property
+// name. Sub-hourly frequencies are rejected; they make no sense for
+// maintenance.
+func parseRRule(rule string) error {
+	seen := map[string]bool{}
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return fmt.Errorf("expected KEY=VALUE, got %q", part)
+		}
+		if seen[key] {
+			return fmt.Errorf("%s is given more than once", key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			if !slices.Contains([]string{"HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}, value) {
+				err = fmt.Errorf("must be one of HOURLY, DAILY, WEEKLY, MONTHLY, YEARLY")
+			}
+		case "INTERVAL", "COUNT":
+			err = rruleNumbers(value, 1, 1<<31-1, false)
+		case "UNTIL":
+			_, err1 := time.Parse("20060102T150405Z", value)
+			_, err2 := time.Parse("20060102", value)
+			if err1 != nil && err2 != nil {
+				err = fmt.Errorf("must be a date (YYYYMMDD) or UTC time (YYYYMMDDTHHMMSSZ)")
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				ordinal := strings.TrimRight(day, "AEFHMORSTUW")
+				if !slices.Contains(rruleWeekdays, day[len(ordinal):]) {
+					err = fmt.Errorf("%q is not a weekday (MO, TU, WE, TH, FR, SA, SU)", day)
+					break
+				}
+				if ordinal != "" {
+					if err = rruleNumbers(ordinal, 1, 53, true); err != nil {
+						break
+					}
+				}
+			}
+		case "BYMONTHDAY":
+			err = rruleNumbers(value, 1, 31, true)
+		case "BYMONTH":
+			err = rruleNumbers(value, 1, 12, false)
+		case "BYHOUR":
+			err = rruleNumbers(value, 0, 23, false)
+		case "BYMINUTE":
+			err = rruleNumbers(value, 0, 59, false)
+		case "BYSETPOS":
+			err = rruleNumbers(value, 1, 366, true)
+		case "WKST":
+			if !slices.Contains(rruleWeekdays, value) {
+				err = fmt.Errorf("must be a weekday (MO, TU, WE, TH, FR, SA, SU)")
+			}
+		default:
+			return fmt.Errorf("unsupported rule part %q", key)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	if !seen["FREQ"] {
+		return fmt.Errorf("FREQ is required")
+	}
+	if seen["COUNT"] && seen["UNTIL"] {
+		return fmt.Errorf("COUNT and UNTIL cannot both be given")
+	}
+	return nil
+}
+
+// rruleNumbers checks a comma-separated list of integers in [min, max].
+// signed also allows the negated range, e.g. BYMONTHDAY=-1 for the last day.
+func rruleNumbers(value string, min, max int, signed bool) error {
+	for _, s := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(s)
+		if signed && n < 0 {
+			n = -n
+		}
+		if err != nil || n < min || n > max {
+			if signed {
+				return fmt.Errorf("%q is not a value between %d and %d or its negative", s, min, max)
+			}
+			return fmt.Errorf("%q is not a value between %d and %d", s, min, max)
+		}
+	}
+	return nil
+}
+
+var _ validator.String = recurrenceValidator{}
+
+// recurrenceValidator rejects strings that parse rejects. kind names the
+// expected syntax in error messages.
+type recurrenceValidator struct {
+	kind  string
+	parse func(string) error
+}
+
+func cronExpression() validator.String {
+	return recurrenceValidator{kind: "a five-field cron expression such as \"0 2 * * SUN\"", parse: parseCron}
+}
+
+func rrule() validator.String {
+	return recurrenceValidator{kind: "an RFC 5545 recurrence rule such as \"FREQ=WEEKLY;BYDAY=SU;BYHOUR=2\"", parse: parseRRule}
+}
+
+func (v recurrenceValidator) Description(_ context.Context) string {
+	return "value must be " + v.kind
+}
+
+func (v recurrenceValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v recurrenceValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := v.parse(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q (%s)", req.Path, v.Description(ctx), req.ConfigValue.ValueString(), err))
+	}
+}
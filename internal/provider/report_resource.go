@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &reportResource{}
+	_ resource.ResourceWithImportState = &reportResource{}
+)
+
+func NewReportResource() resource.Resource {
+	return &reportResource{}
+}
+
+type reportResource struct {
+	client *TinyMonClient
+}
+
+type reportResourceModel struct {
+	ID         types.Int64   `tfsdk:"id"`
+	Name       types.String  `tfsdk:"name"`
+	Recipients types.Set     `tfsdk:"recipients"`
+	Period     types.String  `tfsdk:"period"`
+	Topics     types.Set     `tfsdk:"topics"`
+	Format     types.String  `tfsdk:"format"`
+	SLATarget  types.Float64 `tfsdk:"sla_target"`
+	Enabled    types.Bool    `tfsdk:"enabled"`
+}
+
+type reportAPIRequest struct {
+	Name       string   `json:"name"`
+	Recipients []string `json:"recipients"`
+	Period     string   `json:"period"`
+	Topics     []string `json:"topics"`
+	Format     string   `json:"format"`
+	SLATarget  *float64 `json:"sla_target,omitempty"`
+	Enabled    int      `json:"enabled"`
+}
+
+type reportAPIResponse struct {
+	ID         int64    `json:"id"`
+	Name       string   `json:"name"`
+	Recipients []string `json:"recipients"`
+	Period     string   `json:"period"`
+	Topics     []string `json:"topics"`
+	Format     string   `json:"format"`
+	SLATarget  *float64 `json:"sla_target"`
+	Enabled    int      `json:"enabled"`
+}
+
+func (r *reportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_report"
+}
+
+func (r *reportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a scheduled uptime/SLA report that TinyMon emails to a list of recipients.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Report name, used as the email subject.",
+				Required:    true,
+			},
+			"recipients": schema.SetAttribute{
+				Description: "Email addresses the report is sent to.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"period": schema.StringAttribute{
+				Description: "How often the report is sent, each covering the past period (daily, weekly, monthly).",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("weekly"),
+				Validators: []validator.String{
+					stringOneOf("daily", "weekly", "monthly"),
+				},
+			},
+			"topics": schema.SetAttribute{
+				Description: "Only include hosts in these topics. All hosts if unset.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Attachment format (pdf, html, csv).",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("pdf"),
+				Validators: []validator.String{
+					stringOneOf("pdf", "html", "csv"),
+				},
+			},
+			"sla_target": schema.Float64Attribute{
+				Description: "Uptime target in percent. Checks below it are highlighted in the report.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64Between(0, 100),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *reportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *reportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan reportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+
+	var recipients []string
+	resp.Diagnostics.Append(plan.Recipients.ElementsAs(ctx, &recipients, false)...)
+	topics := []string{}
+	if !plan.Topics.IsNull() {
+		resp.Diagnostics.Append(plan.Topics.ElementsAs(ctx, &topics, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := reportAPIRequest{
+		Name:       plan.Name.ValueString(),
+		Recipients: recipients,
+		Period:     plan.Period.ValueString(),
+		Topics:     topics,
+		Format:     plan.Format.ValueString(),
+		SLATarget:  plan.SLATarget.ValueFloat64Pointer(),
+		Enabled:    enabled,
+	}
+
+	var result reportAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/reports", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating report", err)
+		return
+	}
+
+	mapReportResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *reportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state reportResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/reports/%d", state.ID.ValueInt64())
+
+	var result reportAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading report", err)
+		return
+	}
+
+	mapReportResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *reportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan reportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+
+	var recipients []string
+	resp.Diagnostics.Append(plan.Recipients.ElementsAs(ctx, &recipients, false)...)
+	topics := []string{}
+	if !plan.Topics.IsNull() {
+		resp.Diagnostics.Append(plan.Topics.ElementsAs(ctx, &topics, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := reportAPIRequest{
+		Name:       plan.Name.ValueString(),
+		Recipients: recipients,
+		Period:     plan.Period.ValueString(),
+		Topics:     topics,
+		Format:     plan.Format.ValueString(),
+		SLATarget:  plan.SLATarget.ValueFloat64Pointer(),
+		Enabled:    enabled,
+	}
+
+	apiPath := fmt.Sprintf("/api/push/reports/%d", plan.ID.ValueInt64())
+
+	var result reportAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating report", err)
+		return
+	}
+
+	mapReportResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *reportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state reportResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/reports/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting report", err)
+		return
+	}
+}
+
+func (r *reportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric report ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func mapReportResponseToState(apiResp *reportAPIResponse, state *reportResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.Recipients = stringSetValue(apiResp.Recipients)
+	state.Period = types.StringValue(apiResp.Period)
+	state.Format = types.StringValue(apiResp.Format)
+	state.SLATarget = types.Float64PointerValue(apiResp.SLATarget)
+	state.Enabled = types.BoolValue(apiResp.Enabled != 0)
+
+	// An empty topic list means "all hosts". Keep whichever of unset or []
+	// the configuration used so both plan clean.
+	if len(apiResp.Topics) == 0 && (state.Topics.IsNull() || len(state.Topics.Elements()) == 0) {
+		return
+	}
+	state.Topics = stringSetValue(apiResp.Topics)
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+var (
+	_ action.Action              = &runCheckAction{}
+	_ action.ActionWithConfigure = &runCheckAction{}
+)
+
+func NewRunCheckAction() action.Action {
+	return &runCheckAction{}
+}
+
+type runCheckAction struct {
+	client *TinyMonClient
+}
+
+type runCheckActionModel struct {
+	CheckID        types.Int64 `tfsdk:"check_id"`
+	Wait           types.Bool  `tfsdk:"wait"`
+	TimeoutSeconds types.Int64 `tfsdk:"timeout_seconds"`
+	FailOnCritical types.Bool  `tfsdk:"fail_on_critical"`
+}
+
+func (a *runCheckAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_check"
+}
+
+func (a *runCheckAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a check immediately instead of waiting for its next interval, e.g. after a deployment.",
+		Attributes: map[string]schema.Attribute{
+			"check_id": schema.Int64Attribute{
+				Description: "ID of the check to run.",
+				Required:    true,
+			},
+			"wait": schema.BoolAttribute{
+				Description: "Wait for the result of the run. Defaults to false.",
+				Optional:    true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "How long to wait for the result when wait is set. Defaults to 300.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 3600),
+				},
+			},
+			"fail_on_critical": schema.BoolAttribute{
+				Description: "Fail the action if the result is critical. Only applies when wait is set. Defaults to true.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *runCheckAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	a.client = client
+}
+
+func (a *runCheckAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config runCheckActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.CheckID.ValueInt64()
+	wait := config.Wait.ValueBool()
+
+	// Remember the current result so the wait below ignores it.
+	var previousCheckedAt string
+	if wait {
+		previous, err := a.client.GetCheckResult(tinymon.WithoutReadCache(ctx), id)
+		switch {
+		case err == nil:
+			previousCheckedAt = previous.CheckedAt
+		case !isNotFound(err):
+			addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading check result", err)
+			return
+		}
+	}
+
+	if err := a.client.RunCheck(ctx, id); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error running check", err)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Triggered run of check %d", id)})
+
+	if !wait {
+		return
+	}
+
+	timeout := 300 * time.Second
+	if !config.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(config.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+	result, err := waitForCheckResult(ctx, a.client, id, previousCheckedAt, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for check result", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Check %d is %s (%d ms): %s", id, result.Status, result.LatencyMS, result.Message),
+	})
+
+	failOnCritical := config.FailOnCritical.IsNull() || config.FailOnCritical.ValueBool()
+	if failOnCritical && result.Status == "critical" {
+		resp.Diagnostics.AddError("Check is critical",
+			fmt.Sprintf("Check %d returned critical: %s", id, result.Message))
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &serverInfoDataSource{}
+
+func NewServerInfoDataSource() datasource.DataSource {
+	return &serverInfoDataSource{}
+}
+
+type serverInfoDataSource struct {
+	client *TinyMonClient
+}
+
+type serverInfoDataSourceModel struct {
+	Version            types.String `tfsdk:"version"`
+	Edition            types.String `tfsdk:"edition"`
+	Features           types.Set    `tfsdk:"features"`
+	MaxChecks          types.Int64  `tfsdk:"max_checks"`
+	MinIntervalSeconds types.Int64  `tfsdk:"min_interval_seconds"`
+}
+
+func (d *serverInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_info"
+}
+
+func (d *serverInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the version, edition, features and limits of the TinyMon server, so modules can adapt to the target instance.",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Description: "Server version, e.g. 1.9.2. Null if the server does not report it.",
+				Computed:    true,
+			},
+			"edition": schema.StringAttribute{
+				Description: "Server edition, e.g. community. Null if the server does not report it.",
+				Computed:    true,
+			},
+			"features": schema.SetAttribute{
+				Description: "Feature flags enabled on the server, e.g. probes or organizations. Empty if the server does not report them.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"max_checks": schema.Int64Attribute{
+				Description: "Maximum number of checks; 0 means unlimited. Null if the server does not expose its limits.",
+				Computed:    true,
+			},
+			"min_interval_seconds": schema.Int64Attribute{
+				Description: "Shortest allowed check interval. Null if the server does not expose its limits.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *serverInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *serverInfoDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var info versionAPIResponse
+	if err := d.client.DoJSON(ctx, "GET", "/api/push/version", nil, &info); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading server version", err)
+		return
+	}
+
+	limits, err := d.client.Limits(ctx)
+	if err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading server limits", err)
+		return
+	}
+
+	state := serverInfoDataSourceModel{
+		Version:            stringOrNull(info.Version),
+		Edition:            stringOrNull(info.Edition),
+		Features:           stringSetValue(info.Features),
+		MaxChecks:          types.Int64Null(),
+		MinIntervalSeconds: types.Int64Null(),
+	}
+	if limits != nil {
+		state.MaxChecks = types.Int64Value(limits.MaxChecks)
+		state.MinIntervalSeconds = types.Int64Value(limits.MinIntervalSeconds)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ resource.Resource                   = &sloResource{}
+	_ resource.ResourceWithImportState    = &sloResource{}
+	_ resource.ResourceWithValidateConfig = &sloResource{}
+)
+
+var sloErrorBudgetPolicyAttrTypes = map[string]attr.Type{
+	"alert_at_consumed_percent": types.Float64Type,
+	"notification_channel_ids":  types.SetType{ElemType: types.Int64Type},
+}
+
+func NewSLOResource() resource.Resource {
+	return &sloResource{}
+}
+
+type sloResource struct {
+	client *TinyMonClient
+}
+
+type sloResourceModel struct {
+	ID                types.Int64   `tfsdk:"id"`
+	Name              types.String  `tfsdk:"name"`
+	CheckID           types.Int64   `tfsdk:"check_id"`
+	TagID             types.Int64   `tfsdk:"tag_id"`
+	TargetPercent     types.Float64 `tfsdk:"target_percent"`
+	WindowDays        types.Int64   `tfsdk:"window_days"`
+	ErrorBudgetPolicy types.Object  `tfsdk:"error_budget_policy"`
+
+	AttainmentPercent      types.Float64 `tfsdk:"attainment_percent"`
+	BudgetRemainingPercent types.Float64 `tfsdk:"error_budget_remaining_percent"`
+}
+
+type sloErrorBudgetPolicyModel struct {
+	AlertAtConsumedPercent types.Float64 `tfsdk:"alert_at_consumed_percent"`
+	NotificationChannelIDs types.Set     `tfsdk:"notification_channel_ids"`
+}
+
+type sloAPIRequest struct {
+	Name              string                    `json:"name"`
+	CheckID           int64                     `json:"check_id,omitempty"`
+	TagID             int64                     `json:"tag_id,omitempty"`
+	TargetPercent     float64                   `json:"target_percent"`
+	WindowDays        int64                     `json:"window_days"`
+	ErrorBudgetPolicy *sloErrorBudgetPolicyJSON `json:"error_budget_policy"`
+}
+
+type sloAPIResponse struct {
+	ID                     int64                     `json:"id"`
+	Name                   string                    `json:"name"`
+	CheckID                int64                     `json:"check_id"`
+	TagID                  int64                     `json:"tag_id"`
+	TargetPercent          float64                   `json:"target_percent"`
+	WindowDays             int64                     `json:"window_days"`
+	ErrorBudgetPolicy      *sloErrorBudgetPolicyJSON `json:"error_budget_policy"`
+	AttainmentPercent      *float64                  `json:"attainment_percent"`
+	BudgetRemainingPercent *float64                  `json:"error_budget_remaining_percent"`
+}
+
+type sloErrorBudgetPolicyJSON struct {
+	AlertAtConsumedPercent float64 `json:"alert_at_consumed_percent"`
+	NotificationChannelIDs []int64 `json:"notification_channel_ids"`
+}
+
+func (r *sloResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slo"
+}
+
+func (r *sloResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a service level objective: an availability target over a rolling window for one check, or for all checks carrying a tag.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the SLO.",
+				Required:    true,
+			},
+			"check_id": schema.Int64Attribute{
+				Description: "Check the SLO is measured on. Exactly one of check_id and tag_id is required.",
+				Optional:    true,
+			},
+			"tag_id": schema.Int64Attribute{
+				Description: "Tag (tinymon_tag) whose checks the SLO is measured on together. Exactly one of check_id and tag_id is required.",
+				Optional:    true,
+			},
+			"target_percent": schema.Float64Attribute{
+				Description: "Availability target in percent, e.g. 99.9.",
+				Required:    true,
+				Validators: []validator.Float64{
+					float64Between(0, 100),
+				},
+			},
+			"window_days": schema.Int64Attribute{
+				Description: "Length of the rolling window in days.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(30),
+				Validators: []validator.Int64{
+					int64Between(1, 365),
+				},
+			},
+			"attainment_percent": schema.Float64Attribute{
+				Description: "Availability over the current window. Null until the first result. Updated on refresh.",
+				Computed:    true,
+			},
+			"error_budget_remaining_percent": schema.Float64Attribute{
+				Description: "Share of the error budget left in the current window; negative once the SLO is breached. Updated on refresh.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			// Attributes are Optional because the framework enforces Required
+			// attributes of a single nested block even when it is absent.
+			"error_budget_policy": schema.SingleNestedBlock{
+				Description: "Alert before the error budget runs out.",
+				Attributes: map[string]schema.Attribute{
+					"alert_at_consumed_percent": schema.Float64Attribute{
+						Description: "Alert once this share of the error budget is consumed. Required.",
+						Optional:    true,
+						Validators: []validator.Float64{
+							float64Between(1, 100),
+						},
+					},
+					"notification_channel_ids": schema.SetAttribute{
+						Description: "Notification channels alerted. Defaults to the channels of the measured checks.",
+						ElementType: types.Int64Type,
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *sloResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *sloResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config sloResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case config.CheckID.IsNull() && config.TagID.IsNull():
+		resp.Diagnostics.AddError("Missing SLO subject", "One of check_id and tag_id must be set.")
+	case !config.CheckID.IsNull() && !config.TagID.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("tag_id"), "Conflicting SLO subject",
+			"check_id and tag_id cannot both be set.")
+	}
+
+	if !config.ErrorBudgetPolicy.IsNull() && !config.ErrorBudgetPolicy.IsUnknown() {
+		if value, ok := config.ErrorBudgetPolicy.Attributes()["alert_at_consumed_percent"]; ok && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("error_budget_policy").AtName("alert_at_consumed_percent"),
+				"Missing required argument", "The argument \"alert_at_consumed_percent\" is required in error_budget_policy.")
+		}
+	}
+}
+
+func (r *sloResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sloResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := sloToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result sloAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/slos", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating SLO", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapSLOResponseToState(ctx, &result, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sloResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sloResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/slos/%d", state.ID.ValueInt64())
+
+	var result sloAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading SLO", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapSLOResponseToState(ctx, &result, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *sloResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan sloResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := sloToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/slos/%d", plan.ID.ValueInt64())
+
+	var result sloAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating SLO", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapSLOResponseToState(ctx, &result, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sloResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sloResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/slos/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting SLO", err)
+		return
+	}
+}
+
+func (r *sloResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric SLO ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// sloToAPI sends error_budget_policy as null when the block is absent, which
+// turns budget alerts set earlier off.
+func sloToAPI(ctx context.Context, plan *sloResourceModel) (sloAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := sloAPIRequest{
+		Name:          plan.Name.ValueString(),
+		CheckID:       plan.CheckID.ValueInt64(),
+		TagID:         plan.TagID.ValueInt64(),
+		TargetPercent: plan.TargetPercent.ValueFloat64(),
+		WindowDays:    plan.WindowDays.ValueInt64(),
+	}
+
+	if !plan.ErrorBudgetPolicy.IsNull() {
+		var policy sloErrorBudgetPolicyModel
+		diags.Append(plan.ErrorBudgetPolicy.As(ctx, &policy, basetypes.ObjectAsOptions{})...)
+		body.ErrorBudgetPolicy = &sloErrorBudgetPolicyJSON{
+			AlertAtConsumedPercent: policy.AlertAtConsumedPercent.ValueFloat64(),
+			NotificationChannelIDs: idSetFromPlan(ctx, policy.NotificationChannelIDs, &diags),
+		}
+	}
+
+	return body, diags
+}
+
+func mapSLOResponseToState(ctx context.Context, apiResp *sloAPIResponse, state *sloResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.CheckID = int64OrNull(apiResp.CheckID)
+	state.TagID = int64OrNull(apiResp.TagID)
+	state.TargetPercent = types.Float64Value(apiResp.TargetPercent)
+	state.WindowDays = types.Int64Value(apiResp.WindowDays)
+	state.AttainmentPercent = types.Float64PointerValue(apiResp.AttainmentPercent)
+	state.BudgetRemainingPercent = types.Float64PointerValue(apiResp.BudgetRemainingPercent)
+
+	if apiResp.ErrorBudgetPolicy == nil {
+		state.ErrorBudgetPolicy = types.ObjectNull(sloErrorBudgetPolicyAttrTypes)
+		return diags
+	}
+
+	var current sloErrorBudgetPolicyModel
+	if !state.ErrorBudgetPolicy.IsNull() && !state.ErrorBudgetPolicy.IsUnknown() {
+		diags.Append(state.ErrorBudgetPolicy.As(ctx, &current, basetypes.ObjectAsOptions{})...)
+	}
+
+	policy, d := types.ObjectValueFrom(ctx, sloErrorBudgetPolicyAttrTypes, sloErrorBudgetPolicyModel{
+		AlertAtConsumedPercent: types.Float64Value(apiResp.ErrorBudgetPolicy.AlertAtConsumedPercent),
+		NotificationChannelIDs: idSetToState(apiResp.ErrorBudgetPolicy.NotificationChannelIDs, current.NotificationChannelIDs),
+	})
+	diags.Append(d...)
+	state.ErrorBudgetPolicy = policy
+	return diags
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &statusPageComponentResource{}
+	_ resource.ResourceWithImportState = &statusPageComponentResource{}
+)
+
+func NewStatusPageComponentResource() resource.Resource {
+	return &statusPageComponentResource{}
+}
+
+type statusPageComponentResource struct {
+	client *TinyMonClient
+}
+
+type statusPageComponentResourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Group         types.String `tfsdk:"group"`
+	DisplayOrder  types.Int64  `tfsdk:"display_order"`
+	HostAddresses types.Set    `tfsdk:"host_addresses"`
+	CheckIDs      types.Set    `tfsdk:"check_ids"`
+	Topics        types.Set    `tfsdk:"topics"`
+	Status        types.String `tfsdk:"status"`
+}
+
+type statusPageComponentAPIRequest struct {
+	Name          string   `json:"name"`
+	Description   string   `json:"description,omitempty"`
+	Group         string   `json:"group,omitempty"`
+	DisplayOrder  int64    `json:"display_order"`
+	HostAddresses []string `json:"host_addresses"`
+	CheckIDs      []int64  `json:"check_ids"`
+	Topics        []string `json:"topics"`
+}
+
+type statusPageComponentAPIResponse struct {
+	ID            int64    `json:"id"`
+	Name          string   `json:"name"`
+	Description   string   `json:"description"`
+	Group         string   `json:"group"`
+	DisplayOrder  int64    `json:"display_order"`
+	HostAddresses []string `json:"host_addresses"`
+	CheckIDs      []int64  `json:"check_ids"`
+	Topics        []string `json:"topics"`
+	Status        string   `json:"status"`
+}
+
+func (r *statusPageComponentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_component"
+}
+
+func (r *statusPageComponentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a component of the public status page. A component's status is derived from the hosts, checks and topics mapped to it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name shown on the status page.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Short explanation shown next to the name.",
+				Optional:    true,
+			},
+			"group": schema.StringAttribute{
+				Description: "Heading the component is listed under. Components without a group are listed at the top.",
+				Optional:    true,
+			},
+			"display_order": schema.Int64Attribute{
+				Description: "Position within the group; lower numbers come first. Ties are ordered by name.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+			},
+			"host_addresses": schema.SetAttribute{
+				Description: "Hosts whose checks make up the component.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"check_ids": schema.SetAttribute{
+				Description: "Individual checks that make up the component.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"topics": schema.SetAttribute{
+				Description: "Topics whose hosts make up the component.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Current status shown on the page (operational, degraded, outage, maintenance). A component without mappings is always operational.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *statusPageComponentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *statusPageComponentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan statusPageComponentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := statusPageComponentToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result statusPageComponentAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/status_page/components", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating status page component", err)
+		return
+	}
+
+	mapStatusPageComponentResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *statusPageComponentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state statusPageComponentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/status_page/components/%d", state.ID.ValueInt64())
+
+	var result statusPageComponentAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading status page component", err)
+		return
+	}
+
+	mapStatusPageComponentResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *statusPageComponentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan statusPageComponentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := statusPageComponentToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/status_page/components/%d", plan.ID.ValueInt64())
+
+	var result statusPageComponentAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating status page component", err)
+		return
+	}
+
+	mapStatusPageComponentResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *statusPageComponentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state statusPageComponentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/status_page/components/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting status page component", err)
+		return
+	}
+}
+
+func (r *statusPageComponentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric component ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// statusPageComponentToAPI always sends the mappings, so unset ones clear
+// mappings set earlier.
+func statusPageComponentToAPI(ctx context.Context, plan *statusPageComponentResourceModel) (statusPageComponentAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hostAddresses := []string{}
+	if !plan.HostAddresses.IsNull() {
+		diags.Append(plan.HostAddresses.ElementsAs(ctx, &hostAddresses, false)...)
+	}
+	topics := []string{}
+	if !plan.Topics.IsNull() {
+		diags.Append(plan.Topics.ElementsAs(ctx, &topics, false)...)
+	}
+
+	body := statusPageComponentAPIRequest{
+		Name:          plan.Name.ValueString(),
+		Description:   plan.Description.ValueString(),
+		Group:         plan.Group.ValueString(),
+		DisplayOrder:  plan.DisplayOrder.ValueInt64(),
+		HostAddresses: hostAddresses,
+		CheckIDs:      idSetFromPlan(ctx, plan.CheckIDs, &diags),
+		Topics:        topics,
+	}
+	return body, diags
+}
+
+func mapStatusPageComponentResponseToState(apiResp *statusPageComponentAPIResponse, state *statusPageComponentResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.Description = stringOrNull(apiResp.Description)
+	state.Group = stringOrNull(apiResp.Group)
+	state.DisplayOrder = types.Int64Value(apiResp.DisplayOrder)
+	state.HostAddresses = stringSetToState(apiResp.HostAddresses, state.HostAddresses)
+	state.CheckIDs = idSetToState(apiResp.CheckIDs, state.CheckIDs)
+	state.Topics = stringSetToState(apiResp.Topics, state.Topics)
+	state.Status = types.StringValue(apiResp.Status)
+}
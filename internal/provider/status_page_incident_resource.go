@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &statusPageIncidentResource{}
+	_ resource.ResourceWithImportState    = &statusPageIncidentResource{}
+	_ resource.ResourceWithValidateConfig = &statusPageIncidentResource{}
+)
+
+func NewStatusPageIncidentResource() resource.Resource {
+	return &statusPageIncidentResource{}
+}
+
+type statusPageIncidentResource struct {
+	client *TinyMonClient
+}
+
+type statusPageIncidentResourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Title        types.String `tfsdk:"title"`
+	Body         types.String `tfsdk:"body"`
+	Severity     types.String `tfsdk:"severity"`
+	ComponentIDs types.Set    `tfsdk:"component_ids"`
+	Scheduled    types.Bool   `tfsdk:"scheduled"`
+	StartsAt     types.String `tfsdk:"starts_at"`
+	EndsAt       types.String `tfsdk:"ends_at"`
+	Resolved     types.Bool   `tfsdk:"resolved"`
+}
+
+type statusPageIncidentAPIRequest struct {
+	Title        string  `json:"title"`
+	Body         string  `json:"body"`
+	Severity     string  `json:"severity"`
+	ComponentIDs []int64 `json:"component_ids"`
+	Scheduled    bool    `json:"scheduled"`
+	StartsAt     string  `json:"starts_at,omitempty"`
+	EndsAt       string  `json:"ends_at,omitempty"`
+	Resolved     bool    `json:"resolved"`
+}
+
+type statusPageIncidentAPIResponse struct {
+	ID           int64   `json:"id"`
+	Title        string  `json:"title"`
+	Body         string  `json:"body"`
+	Severity     string  `json:"severity"`
+	ComponentIDs []int64 `json:"component_ids"`
+	Scheduled    bool    `json:"scheduled"`
+	StartsAt     string  `json:"starts_at"`
+	EndsAt       string  `json:"ends_at"`
+	Resolved     bool    `json:"resolved"`
+}
+
+func (r *statusPageIncidentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_incident"
+}
+
+func (r *statusPageIncidentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Posts an incident or announcement to the public status page, e.g. planned maintenance published from a pipeline. Destroying the resource removes the post.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Headline of the post.",
+				Required:    true,
+			},
+			"body": schema.StringAttribute{
+				Description: "Text of the post. Markdown is rendered.",
+				Required:    true,
+			},
+			"severity": schema.StringAttribute{
+				Description: "Impact shown on the page (none, minor, major, critical). Use none for announcements.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("minor"),
+				Validators: []validator.String{
+					stringOneOf("none", "minor", "major", "critical"),
+				},
+			},
+			"component_ids": schema.SetAttribute{
+				Description: "IDs of the affected status page components (tinymon_status_page_component).",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"scheduled": schema.BoolAttribute{
+				Description: "Post as scheduled maintenance for the window from starts_at to ends_at instead of an ongoing incident.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"starts_at": schema.StringAttribute{
+				Description: "Start of the maintenance window (RFC 3339). Required when scheduled.",
+				Optional:    true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"ends_at": schema.StringAttribute{
+				Description: "End of the maintenance window (RFC 3339). Required when scheduled.",
+				Optional:    true,
+				Validators: []validator.String{
+					rfc3339(),
+				},
+			},
+			"resolved": schema.BoolAttribute{
+				Description: "Mark the incident as resolved. Resolved posts stay in the page's history.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *statusPageIncidentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *statusPageIncidentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config statusPageIncidentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Scheduled.IsUnknown() {
+		return
+	}
+
+	window := []struct {
+		name  string
+		value types.String
+	}{
+		{"starts_at", config.StartsAt},
+		{"ends_at", config.EndsAt},
+	}
+	for _, bound := range window {
+		switch {
+		case !config.Scheduled.ValueBool() && !bound.value.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root(bound.name), "Unexpected maintenance window",
+				fmt.Sprintf("%s can only be set when scheduled is true.", bound.name))
+		case config.Scheduled.ValueBool() && bound.value.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root(bound.name), "Missing maintenance window",
+				fmt.Sprintf("%s is required when scheduled is true.", bound.name))
+		}
+	}
+	if config.StartsAt.IsNull() || config.StartsAt.IsUnknown() || config.EndsAt.IsNull() || config.EndsAt.IsUnknown() {
+		return
+	}
+	startsAt, err1 := time.Parse(time.RFC3339, config.StartsAt.ValueString())
+	endsAt, err2 := time.Parse(time.RFC3339, config.EndsAt.ValueString())
+	if err1 == nil && err2 == nil && !endsAt.After(startsAt) {
+		resp.Diagnostics.AddAttributeError(path.Root("ends_at"), "Invalid maintenance window",
+			"ends_at must be after starts_at.")
+	}
+}
+
+func (r *statusPageIncidentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan statusPageIncidentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := statusPageIncidentToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result statusPageIncidentAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/status_page/incidents", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating status page incident", err)
+		return
+	}
+
+	mapStatusPageIncidentResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *statusPageIncidentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state statusPageIncidentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/status_page/incidents/%d", state.ID.ValueInt64())
+
+	var result statusPageIncidentAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading status page incident", err)
+		return
+	}
+
+	mapStatusPageIncidentResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *statusPageIncidentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan statusPageIncidentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := statusPageIncidentToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/status_page/incidents/%d", plan.ID.ValueInt64())
+
+	var result statusPageIncidentAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating status page incident", err)
+		return
+	}
+
+	mapStatusPageIncidentResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *statusPageIncidentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state statusPageIncidentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/status_page/incidents/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting status page incident", err)
+		return
+	}
+}
+
+func (r *statusPageIncidentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric incident ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func statusPageIncidentToAPI(ctx context.Context, plan *statusPageIncidentResourceModel) (statusPageIncidentAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := statusPageIncidentAPIRequest{
+		Title:        plan.Title.ValueString(),
+		Body:         plan.Body.ValueString(),
+		Severity:     plan.Severity.ValueString(),
+		ComponentIDs: idSetFromPlan(ctx, plan.ComponentIDs, &diags),
+		Scheduled:    plan.Scheduled.ValueBool(),
+		StartsAt:     plan.StartsAt.ValueString(),
+		EndsAt:       plan.EndsAt.ValueString(),
+		Resolved:     plan.Resolved.ValueBool(),
+	}
+	return body, diags
+}
+
+func mapStatusPageIncidentResponseToState(apiResp *statusPageIncidentAPIResponse, state *statusPageIncidentResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Title = types.StringValue(apiResp.Title)
+	state.Body = types.StringValue(apiResp.Body)
+	state.Severity = types.StringValue(apiResp.Severity)
+	state.ComponentIDs = idSetToState(apiResp.ComponentIDs, state.ComponentIDs)
+	state.Scheduled = types.BoolValue(apiResp.Scheduled)
+	state.StartsAt = timestampValue(apiResp.StartsAt, state.StartsAt)
+	state.EndsAt = timestampValue(apiResp.EndsAt, state.EndsAt)
+	state.Resolved = types.BoolValue(apiResp.Resolved)
+}
@@ -0,0 +1,702 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ resource.Resource                   = &syntheticCheckResource{}
+	_ resource.ResourceWithImportState    = &syntheticCheckResource{}
+	_ resource.ResourceWithValidateConfig = &syntheticCheckResource{}
+)
+
+var (
+	// syntheticVariableName matches variable names; steps refer to them as
+	// {{name}}.
+	syntheticVariableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// syntheticVariableRef finds {{name}} references in step values.
+	syntheticVariableRef = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+)
+
+var (
+	syntheticStepTypes      = []string{"request", "extract", "assert"}
+	syntheticExtractSources = []string{"json", "regex", "header", "cookie"}
+	syntheticAssertSources  = []string{"status", "body", "json", "header", "duration_ms", "variable"}
+)
+
+var syntheticStepAttrTypes = map[string]attr.Type{
+	"name":            types.StringType,
+	"type":            types.StringType,
+	"method":          types.StringType,
+	"url":             types.StringType,
+	"headers":         types.MapType{ElemType: types.StringType},
+	"body":            types.StringType,
+	"expected_status": types.Int64Type,
+	"source":          types.StringType,
+	"expression":      types.StringType,
+	"variable":        types.StringType,
+	"operator":        types.StringType,
+	"value":           types.StringType,
+}
+
+func NewSyntheticCheckResource() resource.Resource {
+	return &syntheticCheckResource{}
+}
+
+type syntheticCheckResource struct {
+	client *TinyMonClient
+}
+
+type syntheticCheckResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	HostAddress     types.String `tfsdk:"host_address"`
+	Name            types.String `tfsdk:"name"`
+	IntervalSeconds types.Int64  `tfsdk:"interval_seconds"`
+	TimeoutSeconds  types.Int64  `tfsdk:"timeout_seconds"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Variables       types.Map    `tfsdk:"variables"`
+	SecretVariables types.Map    `tfsdk:"secret_variables"`
+	// Steps is a list of syntheticStepModel. It is a types.List so
+	// ValidateConfig can handle steps that are unknown until apply.
+	Steps types.List `tfsdk:"steps"`
+}
+
+type syntheticStepModel struct {
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Method         types.String `tfsdk:"method"`
+	URL            types.String `tfsdk:"url"`
+	Headers        types.Map    `tfsdk:"headers"`
+	Body           types.String `tfsdk:"body"`
+	ExpectedStatus types.Int64  `tfsdk:"expected_status"`
+	Source         types.String `tfsdk:"source"`
+	Expression     types.String `tfsdk:"expression"`
+	Variable       types.String `tfsdk:"variable"`
+	Operator       types.String `tfsdk:"operator"`
+	Value          types.String `tfsdk:"value"`
+}
+
+type syntheticCheckAPIRequest struct {
+	HostAddress     string             `json:"host_address"`
+	Name            string             `json:"name"`
+	IntervalSeconds int64              `json:"interval_seconds"`
+	TimeoutSeconds  int64              `json:"timeout_seconds,omitempty"`
+	Enabled         int                `json:"enabled"`
+	Variables       map[string]string  `json:"variables"`
+	SecretVariables map[string]string  `json:"secret_variables"`
+	Steps           []syntheticAPIStep `json:"steps"`
+}
+
+// syntheticCheckAPIResponse never includes the secret variables; they are
+// write-only on the server and kept from the configuration.
+type syntheticCheckAPIResponse struct {
+	ID              int64              `json:"id"`
+	HostAddress     string             `json:"host_address"`
+	Name            string             `json:"name"`
+	IntervalSeconds int64              `json:"interval_seconds"`
+	TimeoutSeconds  int64              `json:"timeout_seconds"`
+	Enabled         int                `json:"enabled"`
+	Variables       map[string]string  `json:"variables"`
+	Steps           []syntheticAPIStep `json:"steps"`
+}
+
+type syntheticAPIStep struct {
+	Name           string            `json:"name,omitempty"`
+	Type           string            `json:"type"`
+	Method         string            `json:"method,omitempty"`
+	URL            string            `json:"url,omitempty"`
+	Headers        map[string]string `json:"headers,omitempty"`
+	Body           string            `json:"body,omitempty"`
+	ExpectedStatus int64             `json:"expected_status,omitempty"`
+	Source         string            `json:"source,omitempty"`
+	Expression     string            `json:"expression,omitempty"`
+	Variable       string            `json:"variable,omitempty"`
+	Operator       string            `json:"operator,omitempty"`
+	Value          *string           `json:"value,omitempty"`
+}
+
+func (r *syntheticCheckResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synthetic_check"
+}
+
+func (r *syntheticCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a multi-step synthetic check. Steps run in order and share cookies and variables, so flows such as a login can be monitored. A failed step fails the run.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"host_address": schema.StringAttribute{
+				Description: "Address of the host the check belongs to. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the check.",
+				Required:    true,
+			},
+			"interval_seconds": schema.Int64Attribute{
+				Description: "Check interval in seconds (60-86400).",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64Between(60, 86400),
+				},
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Time limit for the whole run in seconds. Defaults to the server's limit.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64Between(1, 600),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"variables": schema.MapAttribute{
+				Description: "Variables available to all steps as {{name}}.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"secret_variables": schema.MapAttribute{
+				Description: "Variables such as passwords, used like variables but masked in results. Not returned by the server, so changes made outside Terraform are not detected.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"steps": schema.ListNestedAttribute{
+				Description: "Steps in execution order. The first step must be a request; extract and assert steps use the response of the latest request.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Step name shown in results.",
+							Optional:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Step type (request, extract, assert).",
+							Required:    true,
+							Validators: []validator.String{
+								stringOneOf(syntheticStepTypes...),
+							},
+						},
+						"method": schema.StringAttribute{
+							Description: "request: HTTP method. Defaults to GET on the server.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringOneOf("GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"),
+							},
+						},
+						"url": schema.StringAttribute{
+							Description: "request: absolute http or https URL. May contain {{name}} variables. Required for request steps.",
+							Optional:    true,
+						},
+						"headers": schema.MapAttribute{
+							Description: "request: request headers. Values may contain {{name}} variables.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"body": schema.StringAttribute{
+							Description: "request: request body. May contain {{name}} variables.",
+							Optional:    true,
+						},
+						"expected_status": schema.Int64Attribute{
+							Description: "request: expected HTTP status code. Defaults to any 2xx or 3xx on the server.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64Between(100, 599),
+							},
+						},
+						"source": schema.StringAttribute{
+							Description: "extract: where the value comes from (json, regex, header, cookie). assert: what is tested (status, body, json, header, duration_ms, variable).",
+							Optional:    true,
+						},
+						"expression": schema.StringAttribute{
+							Description: "JSONPath for json, RE2 expression with one capture group for regex, or the header, cookie or variable name.",
+							Optional:    true,
+						},
+						"variable": schema.StringAttribute{
+							Description: "extract: variable the value is stored in, usable as {{name}} in later steps.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringMatches(syntheticVariableName, "a variable name of letters, digits and underscores"),
+							},
+						},
+						"operator": schema.StringAttribute{
+							Description: "assert: comparison with value (eq, ne, lt, le, gt, ge, contains, regex), or exists / not_exists.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringOneOf(jsonAssertionOperators...),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "assert: expected value. May contain {{name}} variables.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *syntheticCheckResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig checks that every step has the attributes of its type and
+// that steps only use variables defined before them.
+func (r *syntheticCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config syntheticCheckResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Variables of unknown maps or steps are not checked.
+	defined := map[string]bool{}
+	checkRefs := !config.Variables.IsUnknown() && !config.SecretVariables.IsUnknown()
+	for _, vars := range []types.Map{config.Variables, config.SecretVariables} {
+		for name := range vars.Elements() {
+			defined[name] = true
+		}
+	}
+	for _, vars := range []struct {
+		name  string
+		value types.Map
+	}{{"variables", config.Variables}, {"secret_variables", config.SecretVariables}} {
+		for name := range vars.value.Elements() {
+			if !syntheticVariableName.MatchString(name) {
+				resp.Diagnostics.AddAttributeError(path.Root(vars.name).AtMapKey(name), "Invalid variable name",
+					fmt.Sprintf("Variable names may contain letters, digits and underscores and must not start with a digit, got: %q", name))
+			}
+		}
+	}
+
+	if config.Steps.IsUnknown() {
+		return
+	}
+	for i, elem := range config.Steps.Elements() {
+		stepPath := path.Root("steps").AtListIndex(i)
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsUnknown() {
+			checkRefs = false
+			continue
+		}
+		var step syntheticStepModel
+		resp.Diagnostics.Append(obj.As(ctx, &step, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if step.Type.IsUnknown() {
+			checkRefs = false
+			continue
+		}
+		if i == 0 && step.Type.ValueString() != "request" {
+			resp.Diagnostics.AddAttributeError(stepPath.AtName("type"), "Invalid first step",
+				"The first step must be a request; extract and assert steps need a response.")
+		}
+
+		if checkRefs {
+			for _, value := range []struct {
+				name  string
+				value types.String
+			}{{"url", step.URL}, {"body", step.Body}, {"value", step.Value}} {
+				validateSyntheticRefs(&resp.Diagnostics, stepPath.AtName(value.name), value.value, defined)
+			}
+			if step.Headers.IsUnknown() {
+				checkRefs = false
+			}
+			for name, header := range step.Headers.Elements() {
+				if header, ok := header.(types.String); ok {
+					validateSyntheticRefs(&resp.Diagnostics, stepPath.AtName("headers").AtMapKey(name), header, defined)
+				}
+			}
+		}
+
+		validateSyntheticStep(&resp.Diagnostics, stepPath, step)
+
+		if step.Type.ValueString() == "extract" {
+			if step.Variable.IsUnknown() {
+				checkRefs = false
+			} else if !step.Variable.IsNull() {
+				defined[step.Variable.ValueString()] = true
+			}
+		}
+	}
+}
+
+// validateSyntheticStep checks the attributes of a step against its type.
+func validateSyntheticStep(diags *diag.Diagnostics, stepPath path.Path, step syntheticStepModel) {
+	stepType := step.Type.ValueString()
+	attributes := []struct {
+		name   string
+		isNull bool
+		usedBy string
+	}{
+		{"method", step.Method.IsNull(), "request"},
+		{"url", step.URL.IsNull(), "request"},
+		{"headers", step.Headers.IsNull(), "request"},
+		{"body", step.Body.IsNull(), "request"},
+		{"expected_status", step.ExpectedStatus.IsNull(), "request"},
+		{"variable", step.Variable.IsNull(), "extract"},
+		{"operator", step.Operator.IsNull(), "assert"},
+		{"value", step.Value.IsNull(), "assert"},
+	}
+	for _, a := range attributes {
+		if !a.isNull && a.usedBy != stepType {
+			diags.AddAttributeError(stepPath.AtName(a.name), "Invalid attribute combination",
+				fmt.Sprintf("%s is only used by %s steps, not by %s steps.", a.name, a.usedBy, stepType))
+		}
+	}
+
+	require := func(name string, isNull bool) {
+		if isNull {
+			diags.AddAttributeError(stepPath.AtName(name), "Missing required argument",
+				fmt.Sprintf("%s is required for %s steps.", name, stepType))
+		}
+	}
+
+	switch stepType {
+	case "request":
+		require("url", step.URL.IsNull())
+		if !step.Source.IsNull() || !step.Expression.IsNull() {
+			diags.AddAttributeError(stepPath, "Invalid attribute combination",
+				"source and expression are not used by request steps.")
+		}
+		if !step.URL.IsNull() && !step.URL.IsUnknown() && !syntheticVariableRef.MatchString(step.URL.ValueString()) {
+			validateCheckURL(diags, stepPath.AtName("url"), step.URL)
+		}
+	case "extract":
+		require("source", step.Source.IsNull())
+		require("expression", step.Expression.IsNull())
+		require("variable", step.Variable.IsNull())
+		validateSyntheticSource(diags, stepPath, step, syntheticExtractSources)
+	case "assert":
+		require("source", step.Source.IsNull())
+		require("operator", step.Operator.IsNull())
+		validateSyntheticSource(diags, stepPath, step, syntheticAssertSources)
+		if step.Operator.IsNull() || step.Operator.IsUnknown() {
+			return
+		}
+		switch step.Operator.ValueString() {
+		case "exists", "not_exists":
+			if !step.Value.IsNull() {
+				diags.AddAttributeError(stepPath.AtName("value"), "Invalid attribute combination",
+					fmt.Sprintf("operator %q takes no value.", step.Operator.ValueString()))
+			}
+		default:
+			require("value", step.Value.IsNull())
+			if !syntheticVariableRef.MatchString(step.Value.ValueString()) {
+				validateComparison(diags, stepPath.AtName("value"), step.Operator, step.Value)
+			}
+		}
+	}
+}
+
+// validateSyntheticSource checks source against the sources of the step type
+// and the expression against the source.
+func validateSyntheticSource(diags *diag.Diagnostics, stepPath path.Path, step syntheticStepModel, sources []string) {
+	if step.Source.IsNull() || step.Source.IsUnknown() {
+		return
+	}
+	source := step.Source.ValueString()
+	if !slices.Contains(sources, source) {
+		diags.AddAttributeError(stepPath.AtName("source"), "Invalid source",
+			fmt.Sprintf("%s steps take one of %v as source, got: %q", step.Type.ValueString(), sources, source))
+		return
+	}
+
+	switch source {
+	case "status", "body", "duration_ms":
+		if !step.Expression.IsNull() {
+			diags.AddAttributeError(stepPath.AtName("expression"), "Invalid attribute combination",
+				fmt.Sprintf("source %q takes no expression.", source))
+		}
+		return
+	}
+	if step.Expression.IsNull() {
+		diags.AddAttributeError(stepPath.AtName("expression"), "Missing required argument",
+			fmt.Sprintf("expression is required for source %q.", source))
+		return
+	}
+	if step.Expression.IsUnknown() {
+		return
+	}
+	expression := step.Expression.ValueString()
+	switch source {
+	case "json":
+		if !jsonPath.MatchString(expression) {
+			diags.AddAttributeError(stepPath.AtName("expression"), "Invalid JSONPath",
+				fmt.Sprintf("expression must be a JSONPath starting with $ using .name, ['name'], [n] or [*], got: %q", expression))
+		}
+	case "regex":
+		re, err := regexp.Compile(expression)
+		if err != nil {
+			diags.AddAttributeError(stepPath.AtName("expression"), "Invalid regular expression", err.Error())
+		} else if re.NumSubexp() != 1 {
+			diags.AddAttributeError(stepPath.AtName("expression"), "Invalid regular expression",
+				fmt.Sprintf("expression must have exactly one capture group, got %d.", re.NumSubexp()))
+		}
+	}
+}
+
+// validateSyntheticRefs reports {{name}} references to undefined variables.
+func validateSyntheticRefs(diags *diag.Diagnostics, valuePath path.Path, value types.String, defined map[string]bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	for _, match := range syntheticVariableRef.FindAllStringSubmatch(value.ValueString(), -1) {
+		if !defined[match[1]] {
+			diags.AddAttributeError(valuePath, "Undefined variable",
+				fmt.Sprintf("{{%s}} is neither in variables or secret_variables nor extracted by an earlier step.", match[1]))
+		}
+	}
+}
+
+func (r *syntheticCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan syntheticCheckResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := syntheticCheckToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result syntheticCheckAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/synthetic_checks", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating synthetic check", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapSyntheticCheckResponseToState(ctx, &result, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *syntheticCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state syntheticCheckResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/synthetic_checks/%d", state.ID.ValueInt64())
+
+	var result syntheticCheckAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading synthetic check", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapSyntheticCheckResponseToState(ctx, &result, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *syntheticCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan syntheticCheckResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := syntheticCheckToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/synthetic_checks/%d", plan.ID.ValueInt64())
+
+	var result syntheticCheckAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating synthetic check", err)
+		return
+	}
+
+	resp.Diagnostics.Append(mapSyntheticCheckResponseToState(ctx, &result, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *syntheticCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state syntheticCheckResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/synthetic_checks/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting synthetic check", err)
+		return
+	}
+}
+
+func (r *syntheticCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric synthetic check ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func syntheticCheckToAPI(ctx context.Context, plan *syntheticCheckResourceModel) (syntheticCheckAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+
+	variables := map[string]string{}
+	if !plan.Variables.IsNull() {
+		diags.Append(plan.Variables.ElementsAs(ctx, &variables, false)...)
+	}
+	secretVariables := map[string]string{}
+	if !plan.SecretVariables.IsNull() {
+		diags.Append(plan.SecretVariables.ElementsAs(ctx, &secretVariables, false)...)
+	}
+
+	var planSteps []syntheticStepModel
+	diags.Append(plan.Steps.ElementsAs(ctx, &planSteps, false)...)
+
+	steps := make([]syntheticAPIStep, 0, len(planSteps))
+	for _, step := range planSteps {
+		var headers map[string]string
+		if !step.Headers.IsNull() {
+			diags.Append(step.Headers.ElementsAs(ctx, &headers, false)...)
+		}
+		var value *string
+		if !step.Value.IsNull() {
+			value = step.Value.ValueStringPointer()
+		}
+		steps = append(steps, syntheticAPIStep{
+			Name:           step.Name.ValueString(),
+			Type:           step.Type.ValueString(),
+			Method:         step.Method.ValueString(),
+			URL:            step.URL.ValueString(),
+			Headers:        headers,
+			Body:           step.Body.ValueString(),
+			ExpectedStatus: step.ExpectedStatus.ValueInt64(),
+			Source:         step.Source.ValueString(),
+			Expression:     step.Expression.ValueString(),
+			Variable:       step.Variable.ValueString(),
+			Operator:       step.Operator.ValueString(),
+			Value:          value,
+		})
+	}
+
+	body := syntheticCheckAPIRequest{
+		HostAddress:     plan.HostAddress.ValueString(),
+		Name:            plan.Name.ValueString(),
+		IntervalSeconds: plan.IntervalSeconds.ValueInt64(),
+		TimeoutSeconds:  plan.TimeoutSeconds.ValueInt64(),
+		Enabled:         enabled,
+		Variables:       variables,
+		SecretVariables: secretVariables,
+		Steps:           steps,
+	}
+	return body, diags
+}
+
+func mapSyntheticCheckResponseToState(ctx context.Context, apiResp *syntheticCheckAPIResponse, state *syntheticCheckResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.Int64Value(apiResp.ID)
+	state.HostAddress = types.StringValue(apiResp.HostAddress)
+	state.Name = types.StringValue(apiResp.Name)
+	state.IntervalSeconds = types.Int64Value(apiResp.IntervalSeconds)
+	state.TimeoutSeconds = int64OrNull(apiResp.TimeoutSeconds)
+	state.Enabled = types.BoolValue(apiResp.Enabled != 0)
+	state.Variables = stringMapToState(apiResp.Variables, state.Variables)
+	// Secret variables are kept from the configuration. Gives a zero-valued
+	// model its element type.
+	if state.SecretVariables.IsNull() {
+		state.SecretVariables = types.MapNull(types.StringType)
+	}
+
+	var current []syntheticStepModel
+	if !state.Steps.IsNull() && !state.Steps.IsUnknown() {
+		diags.Append(state.Steps.ElementsAs(ctx, &current, false)...)
+	}
+
+	steps := make([]syntheticStepModel, 0, len(apiResp.Steps))
+	for i, step := range apiResp.Steps {
+		// Headers are matched by position so an unset map and {} both plan
+		// clean.
+		currentHeaders := types.MapNull(types.StringType)
+		if i < len(current) {
+			currentHeaders = current[i].Headers
+		}
+		value := types.StringNull()
+		if step.Value != nil {
+			value = types.StringValue(*step.Value)
+		}
+		steps = append(steps, syntheticStepModel{
+			Name:           stringOrNull(step.Name),
+			Type:           types.StringValue(step.Type),
+			Method:         stringOrNull(step.Method),
+			URL:            stringOrNull(step.URL),
+			Headers:        stringMapToState(step.Headers, currentHeaders),
+			Body:           stringOrNull(step.Body),
+			ExpectedStatus: int64OrNull(step.ExpectedStatus),
+			Source:         stringOrNull(step.Source),
+			Expression:     stringOrNull(step.Expression),
+			Variable:       stringOrNull(step.Variable),
+			Operator:       stringOrNull(step.Operator),
+			Value:          value,
+		})
+	}
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: syntheticStepAttrTypes}, steps)
+	diags.Append(d...)
+	state.Steps = list
+	return diags
+}
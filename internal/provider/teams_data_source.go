@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &teamsDataSource{}
+
+func NewTeamsDataSource() datasource.DataSource {
+	return &teamsDataSource{}
+}
+
+type teamsDataSource struct {
+	client *TinyMonClient
+}
+
+type teamsDataSourceModel struct {
+	Name  types.String               `tfsdk:"name"`
+	Teams []teamsDataSourceTeamModel `tfsdk:"teams"`
+}
+
+type teamsDataSourceTeamModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	MemberIDs   types.Set    `tfsdk:"member_ids"`
+}
+
+type teamAPIResponse struct {
+	ID          int64   `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	MemberIDs   []int64 `json:"member_ids"`
+}
+
+func (d *teamsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (d *teamsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists TinyMon teams, optionally filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the team with this name (case-insensitive).",
+				Optional:    true,
+			},
+			"teams": schema.ListNestedAttribute{
+				Description: "Matching teams, ordered by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"member_ids": schema.SetAttribute{
+							Description: "IDs of the users in the team.",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *teamsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *teamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state teamsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := "/api/push/teams"
+	if !state.Name.IsNull() {
+		apiPath += "?name=" + url.QueryEscape(state.Name.ValueString())
+	}
+
+	var result []teamAPIResponse
+	if err := d.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error listing teams", err)
+		return
+	}
+
+	state.Teams = make([]teamsDataSourceTeamModel, 0, len(result))
+	for _, team := range result {
+		state.Teams = append(state.Teams, teamsDataSourceTeamModel{
+			ID:          types.Int64Value(team.ID),
+			Name:        types.StringValue(team.Name),
+			Description: stringOrNull(team.Description),
+			MemberIDs:   int64SetValue(team.MemberIDs),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// timeoutOperations are the operations a timeouts block can bound.
+var timeoutOperations = []string{"create", "read", "update", "delete"}
+
+var timeoutsAttrTypes = map[string]attr.Type{
+	"create": types.StringType,
+	"read":   types.StringType,
+	"update": types.StringType,
+	"delete": types.StringType,
+}
+
+// timeoutsBlock is the timeouts block of hosts and checks. Each attribute is
+// a Go duration such as "30s" or "2m"; unset operations have no deadline.
+func timeoutsBlock() schema.SingleNestedBlock {
+	attrs := make(map[string]schema.Attribute, len(timeoutOperations))
+	for _, op := range timeoutOperations {
+		attrs[op] = schema.StringAttribute{
+			Description: "Maximum duration of " + op + ", e.g. \"30s\" or \"2m\".",
+			Optional:    true,
+			Validators: []validator.String{
+				duration(),
+			},
+		}
+	}
+	return schema.SingleNestedBlock{
+		Description: "Deadlines for the API requests of each operation. Unset operations wait as long as the server takes.",
+		Attributes:  attrs,
+	}
+}
+
+// withTimeout bounds ctx by the timeout configured for op. The returned
+// context is ctx itself when no timeout is set.
+func withTimeout(ctx context.Context, timeouts types.Object, op string) (context.Context, context.CancelFunc) {
+	if timeouts.IsNull() || timeouts.IsUnknown() {
+		return ctx, func() {}
+	}
+	value, ok := timeouts.Attributes()[op].(types.String)
+	if !ok || value.IsNull() || value.IsUnknown() {
+		return ctx, func() {}
+	}
+	// The value passed the duration validator.
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, d)
+}
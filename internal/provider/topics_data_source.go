@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &topicsDataSource{}
+
+func NewTopicsDataSource() datasource.DataSource {
+	return &topicsDataSource{}
+}
+
+type topicsDataSource struct {
+	client *TinyMonClient
+}
+
+type topicsDataSourceModel struct {
+	Prefix types.String                 `tfsdk:"prefix"`
+	Topics []topicsDataSourceTopicModel `tfsdk:"topics"`
+}
+
+type topicsDataSourceTopicModel struct {
+	Path      types.String `tfsdk:"path"`
+	HostCount types.Int64  `tfsdk:"host_count"`
+}
+
+type topicAPIResponse struct {
+	Path      string `json:"path"`
+	HostCount int64  `json:"host_count"`
+}
+
+func (d *topicsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_topics"
+}
+
+func (d *topicsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists topic paths with their host counts.",
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				Description: "Only return topics starting with this prefix, e.g. \"production/\".",
+				Optional:    true,
+			},
+			"topics": schema.ListNestedAttribute{
+				Description: "Matching topics.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Computed: true,
+						},
+						"host_count": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *topicsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *topicsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state topicsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := "/api/push/topics"
+	if !state.Prefix.IsNull() {
+		apiPath += "?prefix=" + url.QueryEscape(state.Prefix.ValueString())
+	}
+
+	var result []topicAPIResponse
+	if err := d.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error listing topics", err)
+		return
+	}
+
+	state.Topics = make([]topicsDataSourceTopicModel, 0, len(result))
+	for _, topic := range result {
+		state.Topics = append(state.Topics, topicsDataSourceTopicModel{
+			Path:      types.StringValue(topic.Path),
+			HostCount: types.Int64Value(topic.HostCount),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
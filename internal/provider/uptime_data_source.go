@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                   = &uptimeDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &uptimeDataSource{}
+)
+
+func NewUptimeDataSource() datasource.DataSource {
+	return &uptimeDataSource{}
+}
+
+type uptimeDataSource struct {
+	client *TinyMonClient
+}
+
+type uptimeDataSourceModel struct {
+	HostAddress     types.String  `tfsdk:"host_address"`
+	CheckID         types.Int64   `tfsdk:"check_id"`
+	Window          types.String  `tfsdk:"window"`
+	UptimePercent   types.Float64 `tfsdk:"uptime_percent"`
+	DowntimeSeconds types.Int64   `tfsdk:"downtime_seconds"`
+	Incidents       types.Int64   `tfsdk:"incidents"`
+}
+
+type uptimeAPIResponse struct {
+	UptimePercent   float64 `json:"uptime_percent"`
+	DowntimeSeconds int64   `json:"downtime_seconds"`
+	Incidents       int64   `json:"incidents"`
+}
+
+func (d *uptimeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_uptime"
+}
+
+func (d *uptimeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the uptime of a host or check over a time window.",
+		Attributes: map[string]schema.Attribute{
+			"host_address": schema.StringAttribute{
+				Description: "Host to report on. Conflicts with check_id.",
+				Optional:    true,
+			},
+			"check_id": schema.Int64Attribute{
+				Description: "Check to report on. Conflicts with host_address.",
+				Optional:    true,
+			},
+			"window": schema.StringAttribute{
+				Description: "Time window: 24h, 7d or 30d.",
+				Required:    true,
+				Validators: []validator.String{
+					stringOneOf("24h", "7d", "30d"),
+				},
+			},
+			"uptime_percent": schema.Float64Attribute{
+				Description: "Uptime in percent over the window.",
+				Computed:    true,
+			},
+			"downtime_seconds": schema.Int64Attribute{
+				Description: "Total downtime over the window.",
+				Computed:    true,
+			},
+			"incidents": schema.Int64Attribute{
+				Description: "Number of outages over the window.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *uptimeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *uptimeDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config uptimeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.HostAddress.IsNull() == config.CheckID.IsNull() {
+		resp.Diagnostics.AddError("Invalid target",
+			"Exactly one of host_address or check_id must be set.")
+	}
+}
+
+func (d *uptimeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state uptimeDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	query.Set("window", state.Window.ValueString())
+	if !state.HostAddress.IsNull() {
+		query.Set("host_address", state.HostAddress.ValueString())
+	}
+	if !state.CheckID.IsNull() {
+		query.Set("check_id", strconv.FormatInt(state.CheckID.ValueInt64(), 10))
+	}
+
+	var result uptimeAPIResponse
+	if err := d.client.DoJSON(ctx, "GET", "/api/push/uptime?"+query.Encode(), nil, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading uptime", err)
+		return
+	}
+
+	state.UptimePercent = types.Float64Value(result.UptimePercent)
+	state.DowntimeSeconds = types.Int64Value(result.DowntimeSeconds)
+	state.Incidents = types.Int64Value(result.Incidents)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
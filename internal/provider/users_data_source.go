@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &usersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+type usersDataSource struct {
+	client *TinyMonClient
+}
+
+type usersDataSourceModel struct {
+	Username types.String               `tfsdk:"username"`
+	Email    types.String               `tfsdk:"email"`
+	Role     types.String               `tfsdk:"role"`
+	Users    []usersDataSourceUserModel `tfsdk:"users"`
+}
+
+type usersDataSourceUserModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Username types.String `tfsdk:"username"`
+	Email    types.String `tfsdk:"email"`
+	Role     types.String `tfsdk:"role"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists TinyMon users, optionally filtered by username, email or role.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Description: "Only return the user with this login name.",
+				Optional:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "Only return users with this email address (case-insensitive).",
+				Optional:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Only return users with this role: admin, editor or viewer.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf("admin", "editor", "viewer"),
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "Matching users, ordered by username.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"username": schema.StringAttribute{
+							Computed: true,
+						},
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+						"role": schema.StringAttribute{
+							Computed: true,
+						},
+						"enabled": schema.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state usersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if !state.Username.IsNull() {
+		query.Set("username", state.Username.ValueString())
+	}
+	if !state.Email.IsNull() {
+		query.Set("email", state.Email.ValueString())
+	}
+	if !state.Role.IsNull() {
+		query.Set("role", state.Role.ValueString())
+	}
+
+	var result []userAPIResponse
+	if err := d.client.DoJSON(ctx, "GET", "/api/push/users/list?"+query.Encode(), nil, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error listing users", err)
+		return
+	}
+
+	state.Users = make([]usersDataSourceUserModel, 0, len(result))
+	for _, user := range result {
+		state.Users = append(state.Users, usersDataSourceUserModel{
+			ID:       types.Int64Value(user.ID),
+			Username: types.StringValue(user.Username),
+			Email:    types.StringValue(user.Email),
+			Role:     types.StringValue(user.Role),
+			Enabled:  types.BoolValue(user.Enabled != 0),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	_ "time/tzdata" // IANA zone names must validate on hosts without a zoneinfo database
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = stringOneOfValidator{}
+
+// stringOneOfValidator rejects string values that are not in a fixed set.
+type stringOneOfValidator struct {
+	values []string
+}
+
+func stringOneOf(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}
+
+func (v stringOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(v.values, req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()))
+	}
+}
+
+var _ validator.String = stringMatchesValidator{}
+
+// stringMatchesValidator rejects string values that do not match a regular
+// expression. description says what the value should look like.
+type stringMatchesValidator struct {
+	re          *regexp.Regexp
+	description string
+}
+
+func stringMatches(re *regexp.Regexp, description string) validator.String {
+	return stringMatchesValidator{re: re, description: description}
+}
+
+func (v stringMatchesValidator) Description(_ context.Context) string {
+	return "value must be " + v.description
+}
+
+func (v stringMatchesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringMatchesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !v.re.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s", req.Path, v.Description(ctx)))
+	}
+}
+
+var _ validator.String = timezoneValidator{}
+
+// timezoneValidator rejects strings that are not IANA time zone names.
+type timezoneValidator struct{}
+
+func timezone() validator.String {
+	return timezoneValidator{}
+}
+
+func (v timezoneValidator) Description(_ context.Context) string {
+	return "value must be an IANA time zone name such as Europe/Berlin or UTC"
+}
+
+func (v timezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// LoadLocation maps "" to UTC and "Local" to the machine's zone; neither
+	// means anything to the server.
+	name := req.ConfigValue.ValueString()
+	if _, err := time.LoadLocation(name); err != nil || name == "" || name == "Local" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), name))
+	}
+}
+
+var _ validator.String = durationValidator{}
+
+// durationValidator rejects strings that are not positive Go durations.
+type durationValidator struct{}
+
+func duration() validator.String {
+	return durationValidator{}
+}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as 30s, 2m or 1h30m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()))
+	}
+}
+
+var _ validator.String = rfc3339Validator{}
+
+// rfc3339Validator rejects strings that are not RFC 3339 timestamps.
+type rfc3339Validator struct{}
+
+func rfc3339() validator.String {
+	return rfc3339Validator{}
+}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp such as 2025-06-01T22:00:00Z"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()))
+	}
+}
+
+var _ validator.String = regularExpressionValidator{}
+
+// regularExpressionValidator rejects strings that are not valid regular
+// expressions. The server uses the same RE2 syntax as Go.
+type regularExpressionValidator struct{}
+
+func regularExpression() validator.String {
+	return regularExpressionValidator{}
+}
+
+func (v regularExpressionValidator) Description(_ context.Context) string {
+	return "value must be a regular expression in RE2 syntax"
+}
+
+func (v regularExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regularExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q (%s)", req.Path, v.Description(ctx), req.ConfigValue.ValueString(), err))
+	}
+}
+
+var _ validator.Int64 = int64BetweenValidator{}
+
+// int64BetweenValidator rejects integers outside [min, max].
+type int64BetweenValidator struct {
+	min, max int64
+}
+
+func int64Between(min, max int64) validator.Int64 {
+	return int64BetweenValidator{min: min, max: max}
+}
+
+func (v int64BetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+func (v int64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64BetweenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if value := req.ConfigValue.ValueInt64(); value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value))
+	}
+}
+
+var _ validator.Float64 = float64BetweenValidator{}
+
+// float64BetweenValidator rejects floats outside [min, max].
+type float64BetweenValidator struct {
+	min, max float64
+}
+
+func float64Between(min, max float64) validator.Float64 {
+	return float64BetweenValidator{min: min, max: max}
+}
+
+func (v float64BetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %g and %g", v.min, v.max)
+}
+
+func (v float64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v float64BetweenValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if value := req.ConfigValue.ValueFloat64(); value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %g", req.Path, v.Description(ctx), value))
+	}
+}
+
+var _ validator.Set = setValuesOneOfValidator{}
+
+// setValuesOneOfValidator rejects string sets containing values outside a
+// fixed set.
+type setValuesOneOfValidator struct {
+	values []string
+}
+
+func setValuesOneOf(values ...string) validator.Set {
+	return setValuesOneOfValidator{values: values}
+}
+
+func (v setValuesOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("each value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v setValuesOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v setValuesOneOfValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if !slices.Contains(v.values, value.ValueString()) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value",
+				fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value.ValueString()))
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringOrNull maps the API's empty string for "not set" to a null value so
+// optional attributes without a default round-trip cleanly.
+func stringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// int64OrNull maps the API's zero for "not set" to a null value.
+func int64OrNull(i int64) types.Int64 {
+	if i == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(i)
+}
+
+// stringSetValue converts an API string list to a set value.
+func stringSetValue(values []string) types.Set {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
+
+// int64SetValue converts an API ID list to a set value.
+func int64SetValue(values []int64) types.Set {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.Int64Value(v))
+	}
+	return types.SetValueMust(types.Int64Type, elems)
+}
+
+// stringListValue converts an API string list to a list value.
+func stringListValue(values []string) types.List {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
+
+// jsonEqual reports whether two JSON documents are semantically equal,
+// ignoring key order and whitespace. Invalid JSON is compared as text.
+func jsonEqual(a, b string) bool {
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// idSetFromPlan returns the IDs in a set of references, e.g. tag_ids. An
+// unset attribute is sent as an empty list so references set earlier are
+// removed.
+func idSetFromPlan(ctx context.Context, ids types.Set, diags *diag.Diagnostics) []int64 {
+	values := []int64{}
+	if !ids.IsNull() && !ids.IsUnknown() {
+		diags.Append(ids.ElementsAs(ctx, &values, false)...)
+	}
+	return values
+}
+
+// idSetToState maps IDs returned by the server to a set of references. No IDs
+// is stored as whichever of unset or [] the configuration used, so both plan
+// clean.
+func idSetToState(ids []int64, current types.Set) types.Set {
+	if len(ids) == 0 && !current.IsUnknown() && (current.IsNull() || len(current.Elements()) == 0) {
+		if current.IsNull() {
+			// Also gives a zero-valued model its element type.
+			return types.SetNull(types.Int64Type)
+		}
+		return current
+	}
+	elems := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		elems = append(elems, types.Int64Value(id))
+	}
+	return types.SetValueMust(types.Int64Type, elems)
+}
+
+// stringSetToState is idSetToState for sets of strings, e.g. topics where
+// an empty set means "none" or "all".
+func stringSetToState(values []string, current types.Set) types.Set {
+	if len(values) == 0 && !current.IsUnknown() && (current.IsNull() || len(current.Elements()) == 0) {
+		if current.IsNull() {
+			return types.SetNull(types.StringType)
+		}
+		return current
+	}
+	return stringSetValue(values)
+}
+
+// stringMapToState maps a map of strings returned by the server, e.g. labels,
+// to state. An empty map stays null unless the configuration has an empty map.
+func stringMapToState(values map[string]string, current types.Map) types.Map {
+	if len(values) == 0 && (current.IsNull() || current.IsUnknown()) {
+		return types.MapNull(types.StringType)
+	}
+	elems := make(map[string]attr.Value, len(values))
+	for key, value := range values {
+		elems[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
+
+// timestampValue maps a timestamp returned by the server to state. The server
+// normalizes timestamps to UTC, so the configured value is kept when it
+// denotes the same instant.
+func timestampValue(apiValue string, current types.String) types.String {
+	if apiValue == "" {
+		return types.StringNull()
+	}
+	if !current.IsNull() && !current.IsUnknown() {
+		configured, err1 := time.Parse(time.RFC3339, current.ValueString())
+		returned, err2 := time.Parse(time.RFC3339, apiValue)
+		if err1 == nil && err2 == nil && configured.Equal(returned) {
+			return current
+		}
+	}
+	return types.StringValue(apiValue)
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// minServerVersion is the oldest TinyMon release this provider is tested
+// against. Older servers still work, but some features fall back to legacy
+// endpoints or are unavailable.
+var minServerVersion = serverVersion{1, 4, 0}
+
+// serverFeature names a capability that depends on the server version.
+type serverFeature struct {
+	name  string
+	since serverVersion
+}
+
+// featureIDEndpoints covers GET/DELETE /api/push/{hosts,checks}/{id}. Older
+// servers only support the address/type/config query form.
+var featureIDEndpoints = serverFeature{name: "id-based endpoints", since: serverVersion{1, 4, 0}}
+
+// serverVersion is a parsed major.minor.patch release number.
+type serverVersion [3]int
+
+func (v serverVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v[0], v[1], v[2])
+}
+
+func (v serverVersion) less(other serverVersion) bool {
+	for i := range v {
+		if v[i] != other[i] {
+			return v[i] < other[i]
+		}
+	}
+	return false
+}
+
+// parseServerVersion accepts "1.4", "1.4.2", "v1.4.2" and ignores pre-release
+// or build suffixes such as "1.4.2-rc1".
+func parseServerVersion(s string) (serverVersion, error) {
+	var v serverVersion
+	core := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexAny(core, "-+"); i >= 0 {
+		core = core[:i]
+	}
+	parts := strings.Split(core, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return v, fmt.Errorf("unrecognized version %q", s)
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return v, fmt.Errorf("unrecognized version %q", s)
+		}
+		v[i] = n
+	}
+	return v, nil
+}
+
+// versionAPIResponse is the body of /api/push/version. Servers before 1.10.0
+// only report the version.
+type versionAPIResponse struct {
+	Version  string   `json:"version"`
+	Edition  string   `json:"edition"`
+	Features []string `json:"features"`
+}
+
+// DetectVersion asks the server for its version and stores it on the client.
+// Servers without the version endpoint predate every gated feature and are
+// recorded as 0.0.0.
+func (c *TinyMonClient) DetectVersion(ctx context.Context) error {
+	var result versionAPIResponse
+	err := c.DoJSON(ctx, "GET", "/api/push/version", nil, &result)
+	if isNotFound(err) {
+		c.version = &serverVersion{}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	v, err := parseServerVersion(result.Version)
+	if err != nil {
+		return err
+	}
+	c.version = &v
+	return nil
+}
+
+// ServerVersion returns the detected server version, or "" when detection
+// was skipped.
+func (c *TinyMonClient) ServerVersion() string {
+	if c.version == nil {
+		return ""
+	}
+	return c.version.String()
+}
+
+// Supports reports whether the server provides feature. When the version is
+// unknown (detection skipped) the server is assumed to be current.
+func (c *TinyMonClient) Supports(feature serverFeature) bool {
+	return c.version == nil || !c.version.less(feature.since)
+}
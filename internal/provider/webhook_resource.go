@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &webhookResource{}
+	_ resource.ResourceWithImportState = &webhookResource{}
+)
+
+// webhookEvents are the state changes a webhook can subscribe to.
+var webhookEvents = []string{
+	"check.critical",
+	"check.warning",
+	"check.recovered",
+	"host.down",
+	"host.up",
+	"alert.acknowledged",
+}
+
+func NewWebhookResource() resource.Resource {
+	return &webhookResource{}
+}
+
+type webhookResource struct {
+	client *TinyMonClient
+}
+
+type webhookResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	URL             types.String `tfsdk:"url"`
+	Secret          types.String `tfsdk:"secret"`
+	Events          types.Set    `tfsdk:"events"`
+	HostAddress     types.String `tfsdk:"host_address"`
+	Topic           types.String `tfsdk:"topic"`
+	PayloadTemplate types.String `tfsdk:"payload_template"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+}
+
+type webhookAPIRequest struct {
+	Name            string   `json:"name"`
+	URL             string   `json:"url"`
+	Secret          string   `json:"secret,omitempty"`
+	Events          []string `json:"events"`
+	HostAddress     string   `json:"host_address,omitempty"`
+	Topic           string   `json:"topic,omitempty"`
+	PayloadTemplate string   `json:"payload_template,omitempty"`
+	Enabled         int      `json:"enabled"`
+}
+
+// webhookAPIResponse does not include the secret; it is write-only on the
+// server and kept from the configuration.
+type webhookAPIResponse struct {
+	ID              int64    `json:"id"`
+	Name            string   `json:"name"`
+	URL             string   `json:"url"`
+	Events          []string `json:"events"`
+	HostAddress     string   `json:"host_address"`
+	Topic           string   `json:"topic"`
+	PayloadTemplate string   `json:"payload_template"`
+	Enabled         int      `json:"enabled"`
+}
+
+func (r *webhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an outbound webhook that notifies other systems of state changes in TinyMon.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the webhook.",
+				Required:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "Target URL that receives a POST request per event.",
+				Required:    true,
+			},
+			"secret": schema.StringAttribute{
+				Description: "Shared secret used to sign payloads (X-TinyMon-Signature header, HMAC-SHA256). Not returned by the server, so changes made outside Terraform are not detected.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"events": schema.SetAttribute{
+				Description: "Events that trigger the webhook. All events if unset.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setValuesOneOf(webhookEvents...),
+				},
+			},
+			"host_address": schema.StringAttribute{
+				Description: "Only send events for the host with this address.",
+				Optional:    true,
+			},
+			"topic": schema.StringAttribute{
+				Description: "Only send events for hosts in this topic.",
+				Optional:    true,
+			},
+			"payload_template": schema.StringAttribute{
+				Description: "Go template for the request body. The default is TinyMon's JSON event document.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *webhookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*TinyMonClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *TinyMonClient, got %T.", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+
+	events := []string{}
+	if !plan.Events.IsNull() {
+		resp.Diagnostics.Append(plan.Events.ElementsAs(ctx, &events, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	body := webhookAPIRequest{
+		Name:            plan.Name.ValueString(),
+		URL:             plan.URL.ValueString(),
+		Secret:          plan.Secret.ValueString(),
+		Events:          events,
+		HostAddress:     plan.HostAddress.ValueString(),
+		Topic:           plan.Topic.ValueString(),
+		PayloadTemplate: plan.PayloadTemplate.ValueString(),
+		Enabled:         enabled,
+	}
+
+	var result webhookAPIResponse
+	if err := r.client.DoJSON(ctx, "POST", "/api/push/webhooks", body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error creating webhook", err)
+		return
+	}
+
+	mapWebhookResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/webhooks/%d", state.ID.ValueInt64())
+
+	var result webhookAPIResponse
+	if err := r.client.DoJSON(ctx, "GET", apiPath, nil, &result); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error reading webhook", err)
+		return
+	}
+
+	mapWebhookResponseToState(&result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled := 1
+	if !plan.Enabled.IsNull() && !plan.Enabled.ValueBool() {
+		enabled = 0
+	}
+
+	events := []string{}
+	if !plan.Events.IsNull() {
+		resp.Diagnostics.Append(plan.Events.ElementsAs(ctx, &events, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	body := webhookAPIRequest{
+		Name:            plan.Name.ValueString(),
+		URL:             plan.URL.ValueString(),
+		Secret:          plan.Secret.ValueString(),
+		Events:          events,
+		HostAddress:     plan.HostAddress.ValueString(),
+		Topic:           plan.Topic.ValueString(),
+		PayloadTemplate: plan.PayloadTemplate.ValueString(),
+		Enabled:         enabled,
+	}
+
+	apiPath := fmt.Sprintf("/api/push/webhooks/%d", plan.ID.ValueInt64())
+
+	var result webhookAPIResponse
+	if err := r.client.DoJSON(ctx, "PUT", apiPath, body, &result); err != nil {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error updating webhook", err)
+		return
+	}
+
+	mapWebhookResponseToState(&result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state webhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := fmt.Sprintf("/api/push/webhooks/%d", state.ID.ValueInt64())
+	if err := r.client.DoJSON(ctx, "DELETE", apiPath, nil, nil); err != nil && !isNotFound(err) {
+		addAPIErrorDiagnostics(&resp.Diagnostics, "Error deleting webhook", err)
+		return
+	}
+}
+
+func (r *webhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the numeric webhook ID.")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func mapWebhookResponseToState(apiResp *webhookAPIResponse, state *webhookResourceModel) {
+	state.ID = types.Int64Value(apiResp.ID)
+	state.Name = types.StringValue(apiResp.Name)
+	state.URL = types.StringValue(apiResp.URL)
+	state.HostAddress = stringOrNull(apiResp.HostAddress)
+	state.Topic = stringOrNull(apiResp.Topic)
+	state.PayloadTemplate = stringOrNull(apiResp.PayloadTemplate)
+	state.Enabled = types.BoolValue(apiResp.Enabled != 0)
+
+	// An empty event list means "all events". Keep whichever of unset or []
+	// the configuration used so both plan clean.
+	if len(apiResp.Events) == 0 && (state.Events.IsNull() || len(state.Events.Elements()) == 0) {
+		return
+	}
+	state.Events = stringSetValue(apiResp.Events)
+}
@@ -0,0 +1,135 @@
+// Package sweep deletes test hosts and checks left behind on a TinyMon
+// instance. Test objects are named with Prefix; a failed or interrupted run
+// against a real server can leave them behind. cmd/tinymon-sweep runs the
+// sweepers.
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+// Prefix starts the address or name of every host and check created for
+// testing against a real TinyMon.
+const Prefix = "tf-acc-"
+
+// errEmptyPrefix guards against sweeping every host on the instance.
+var errEmptyPrefix = errors.New("sweep: empty prefix would match every host")
+
+// Client is the part of the TinyMon API the sweepers use. *tinymon.Client
+// implements it.
+type Client interface {
+	ListHosts(ctx context.Context, opts tinymon.ListHostsOptions) ([]tinymon.Host, error)
+	DeleteHostByAddress(ctx context.Context, address string) error
+	ListChecks(ctx context.Context, opts tinymon.ListChecksOptions) ([]tinymon.Check, error)
+	DeleteCheckByKey(ctx context.Context, key tinymon.CheckKey) error
+}
+
+// Sweeper deletes hosts and checks whose address or name starts with Prefix.
+type Sweeper struct {
+	Client Client
+	// Prefix selects the objects to delete. It must not be empty.
+	Prefix string
+	// DryRun only logs what would be deleted.
+	DryRun bool
+	// Logf, if set, receives one line per deleted object.
+	Logf func(format string, args ...interface{})
+}
+
+// Sweep deletes matching checks and then matching hosts. It keeps going
+// after a failed deletion and returns all errors joined.
+func (s *Sweeper) Sweep(ctx context.Context) error {
+	if s.Prefix == "" {
+		return errEmptyPrefix
+	}
+	return errors.Join(s.SweepChecks(ctx), s.SweepHosts(ctx))
+}
+
+// SweepChecks deletes checks whose name or host address starts with Prefix.
+// Deleting a host deletes its checks too; this also catches test checks on
+// hosts that are not test hosts themselves.
+func (s *Sweeper) SweepChecks(ctx context.Context) error {
+	if s.Prefix == "" {
+		return errEmptyPrefix
+	}
+	checks, err := s.listChecks(ctx)
+	if err != nil {
+		return fmt.Errorf("listing checks: %w", err)
+	}
+
+	var errs []error
+	for _, check := range checks {
+		if !strings.HasPrefix(check.Name, s.Prefix) && !strings.HasPrefix(check.HostAddress, s.Prefix) {
+			continue
+		}
+		s.logf("deleting check %d (%s %s)", check.ID, check.HostAddress, check.Type)
+		if s.DryRun {
+			continue
+		}
+		key := tinymon.CheckKey{HostAddress: check.HostAddress, Type: check.Type, Config: check.Config}
+		if err := s.Client.DeleteCheckByKey(ctx, key); err != nil && !tinymon.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting check %d: %w", check.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SweepHosts deletes hosts whose address or name starts with Prefix, along
+// with their checks.
+func (s *Sweeper) SweepHosts(ctx context.Context) error {
+	if s.Prefix == "" {
+		return errEmptyPrefix
+	}
+	hosts, err := s.Client.ListHosts(ctx, tinymon.ListHostsOptions{})
+	if err != nil {
+		return fmt.Errorf("listing hosts: %w", err)
+	}
+
+	var errs []error
+	for _, host := range hosts {
+		if !strings.HasPrefix(host.Address, s.Prefix) && !strings.HasPrefix(host.Name, s.Prefix) {
+			continue
+		}
+		s.logf("deleting host %d (%s)", host.ID, host.Address)
+		if s.DryRun {
+			continue
+		}
+		if err := s.Client.DeleteHostByAddress(ctx, host.Address); err != nil && !tinymon.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting host %s: %w", host.Address, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// listChecks returns the checks of all hosts. The list endpoint filters by
+// host or topic, so the checks are listed host by host.
+func (s *Sweeper) listChecks(ctx context.Context) ([]tinymon.Check, error) {
+	hosts, err := s.Client.ListHosts(ctx, tinymon.ListHostsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var checks []tinymon.Check
+	for _, host := range hosts {
+		hostChecks, err := s.Client.ListChecks(ctx, tinymon.ListChecksOptions{HostAddress: host.Address})
+		if err != nil && !tinymon.IsNotFound(err) {
+			return nil, fmt.Errorf("host %s: %w", host.Address, err)
+		}
+		checks = append(checks, hostChecks...)
+	}
+	return checks, nil
+}
+
+func (s *Sweeper) logf(format string, args ...interface{}) {
+	if s.Logf == nil {
+		return
+	}
+	if s.DryRun {
+		format = "[dry run] " + format
+	}
+	s.Logf(format, args...)
+}
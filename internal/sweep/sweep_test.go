@@ -0,0 +1,123 @@
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon/tinymontest"
+)
+
+// newTestServer returns a fake TinyMon with test and non-test objects:
+//
+//   - tf-acc-web1: test host by address, with a check
+//   - web2 named tf-acc-web2: test host by name
+//   - prod1: real host with a real check and a tf-acc- check
+func newTestServer(t *testing.T) *tinymontest.Server {
+	t.Helper()
+	srv := tinymontest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddHost(tinymon.HostRequest{Address: "tf-acc-web1", Name: "Web 1"})
+	srv.AddHost(tinymon.HostRequest{Address: "web2", Name: "tf-acc-web2"})
+	srv.AddHost(tinymon.HostRequest{Address: "prod1", Name: "Production"})
+	srv.AddCheck(tinymon.CheckRequest{HostAddress: "tf-acc-web1", Type: "ping", Name: "Ping"})
+	srv.AddCheck(tinymon.CheckRequest{HostAddress: "prod1", Type: "ping", Name: "Ping"})
+	srv.AddCheck(tinymon.CheckRequest{HostAddress: "prod1", Type: "http", Name: "tf-acc-homepage", Config: `{"url":"https://prod1/"}`})
+	return srv
+}
+
+func hostAddresses(srv *tinymontest.Server) []string {
+	var addresses []string
+	for _, host := range srv.Hosts() {
+		addresses = append(addresses, host.Address)
+	}
+	slices.Sort(addresses)
+	return addresses
+}
+
+func checkNames(srv *tinymontest.Server) []string {
+	var names []string
+	for _, check := range srv.Checks() {
+		names = append(names, check.HostAddress+"/"+check.Name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func TestSweep(t *testing.T) {
+	srv := newTestServer(t)
+	var logged []string
+	s := &Sweeper{
+		Client: srv.Client(),
+		Prefix: Prefix,
+		Logf:   func(format string, args ...interface{}) { logged = append(logged, fmt.Sprintf(format, args...)) },
+	}
+
+	if err := s.Sweep(context.Background()); err != nil {
+		t.Fatalf("Sweep: %s", err)
+	}
+	if got, want := hostAddresses(srv), []string{"prod1"}; !slices.Equal(got, want) {
+		t.Errorf("hosts after sweep = %v, want %v", got, want)
+	}
+	if got, want := checkNames(srv), []string{"prod1/Ping"}; !slices.Equal(got, want) {
+		t.Errorf("checks after sweep = %v, want %v", got, want)
+	}
+	// Two checks and two hosts.
+	if len(logged) != 4 {
+		t.Errorf("logged %d lines, want 4: %q", len(logged), logged)
+	}
+}
+
+func TestSweepDryRun(t *testing.T) {
+	srv := newTestServer(t)
+	hosts, checks := hostAddresses(srv), checkNames(srv)
+	var logged []string
+	s := &Sweeper{
+		Client: srv.Client(),
+		Prefix: Prefix,
+		DryRun: true,
+		Logf:   func(format string, args ...interface{}) { logged = append(logged, fmt.Sprintf(format, args...)) },
+	}
+
+	if err := s.Sweep(context.Background()); err != nil {
+		t.Fatalf("Sweep: %s", err)
+	}
+	if got := hostAddresses(srv); !slices.Equal(got, hosts) {
+		t.Errorf("hosts after dry run = %v, want %v", got, hosts)
+	}
+	if got := checkNames(srv); !slices.Equal(got, checks) {
+		t.Errorf("checks after dry run = %v, want %v", got, checks)
+	}
+	if len(logged) != 4 {
+		t.Errorf("logged %d lines, want 4: %q", len(logged), logged)
+	}
+	for _, line := range logged {
+		if !strings.HasPrefix(line, "[dry run] ") {
+			t.Errorf("log line %q is not marked as a dry run", line)
+		}
+	}
+}
+
+func TestSweepEmptyPrefix(t *testing.T) {
+	srv := newTestServer(t)
+	hosts := hostAddresses(srv)
+	s := &Sweeper{Client: srv.Client()}
+	ctx := context.Background()
+
+	for name, sweep := range map[string]func(context.Context) error{
+		"Sweep":       s.Sweep,
+		"SweepChecks": s.SweepChecks,
+		"SweepHosts":  s.SweepHosts,
+	} {
+		if err := sweep(ctx); !errors.Is(err, errEmptyPrefix) {
+			t.Errorf("%s: got %v, want errEmptyPrefix", name, err)
+		}
+	}
+	if got := hostAddresses(srv); !slices.Equal(got, hosts) {
+		t.Errorf("hosts after empty-prefix sweep = %v, want %v", got, hosts)
+	}
+}
@@ -0,0 +1,72 @@
+package tinymon
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// readCacheTTL is how long a GET response is reused. It covers the burst of
+// reads during a refresh; any write clears the whole cache.
+const readCacheTTL = 10 * time.Second
+
+// readCache holds recent successful GET response bodies keyed by request
+// path (including the query).
+type readCache struct {
+	mu      sync.Mutex
+	entries map[string]readCacheEntry
+	// generation changes on every clear, so a GET that raced with a write
+	// does not store its possibly stale response.
+	generation uint64
+}
+
+type readCacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+func newReadCache() *readCache {
+	return &readCache{entries: map[string]readCacheEntry{}}
+}
+
+// get returns the cached body for path, if any, and the generation to pass
+// to put after fetching it.
+func (c *readCache) get(path string) ([]byte, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[path]
+	if !ok || time.Now().After(entry.expires) {
+		delete(c.entries, path)
+		return nil, c.generation, false
+	}
+	return entry.body, c.generation, true
+}
+
+func (c *readCache) put(path string, body []byte, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	c.entries[path] = readCacheEntry{body: body, expires: time.Now().Add(readCacheTTL)}
+}
+
+func (c *readCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+	c.generation++
+}
+
+type bypassReadCacheKey struct{}
+
+// WithoutReadCache marks ctx so GET requests skip the read cache. Use it when
+// polling for a value that is expected to change.
+func WithoutReadCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassReadCacheKey{}, true)
+}
+
+func readCacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassReadCacheKey{}).(bool)
+	return bypass
+}
@@ -0,0 +1,253 @@
+package tinymon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CheckRequest creates or updates a check. Checks are keyed by host address,
+// type and config.
+type CheckRequest struct {
+	HostAddress     string `json:"host_address"`
+	Type            string `json:"type"`
+	Name            string `json:"name,omitempty"`
+	Config          string `json:"config"`
+	IntervalSeconds int64  `json:"interval_seconds"`
+	Enabled         int    `json:"enabled"`
+	// DependsOnCheckID is sent as 0 when unset, which removes the dependency.
+	DependsOnCheckID int64 `json:"depends_on_check_id"`
+	// TemplateID is sent as 0 when unset, which detaches the template.
+	TemplateID          int64    `json:"template_id"`
+	Locations           []string `json:"locations"`
+	TagIDs              []int64  `json:"tag_ids"`
+	FailuresBeforeAlert int64    `json:"failures_before_alert"`
+	// RetryIntervalSeconds is sent as 0 when unset; the server then retries
+	// at interval_seconds.
+	RetryIntervalSeconds int64 `json:"retry_interval_seconds"`
+	// Thresholds are sent as null when unset, which clears them.
+	WarningThreshold  *CheckThreshold `json:"warning_threshold"`
+	CriticalThreshold *CheckThreshold `json:"critical_threshold"`
+	FlapDetection     *FlapDetection  `json:"flap_detection"`
+	// Secrets holds write-only config values. The server merges them into
+	// the config when running the check and never returns them.
+	Secrets map[string]interface{} `json:"secrets,omitempty"`
+}
+
+// Check is a check as returned by the API.
+type Check struct {
+	ID               int64    `json:"id"`
+	HostID           int64    `json:"host_id"`
+	HostAddress      string   `json:"host_address"`
+	Type             string   `json:"type"`
+	Name             string   `json:"name"`
+	Config           string   `json:"config"`
+	IntervalSeconds  int64    `json:"interval_seconds"`
+	Enabled          int      `json:"enabled"`
+	DependsOnCheckID int64    `json:"depends_on_check_id"`
+	TemplateID       int64    `json:"template_id"`
+	Locations        []string `json:"locations"`
+	TagIDs           []int64  `json:"tag_ids"`
+
+	FailuresBeforeAlert  int64 `json:"failures_before_alert"`
+	RetryIntervalSeconds int64 `json:"retry_interval_seconds"`
+
+	WarningThreshold  *CheckThreshold `json:"warning_threshold"`
+	CriticalThreshold *CheckThreshold `json:"critical_threshold"`
+	FlapDetection     *FlapDetection  `json:"flap_detection"`
+}
+
+// CheckThreshold is a warning or critical threshold. Unset metrics are not
+// evaluated.
+type CheckThreshold struct {
+	LatencyMS         *int64   `json:"latency_ms,omitempty"`
+	PacketLossPercent *float64 `json:"packet_loss_percent,omitempty"`
+	ResponseSizeBytes *int64   `json:"response_size_bytes,omitempty"`
+}
+
+// FlapDetection holds notifications while a check changes state too often.
+type FlapDetection struct {
+	// Enabled is omitted when unset; the server then enables the settings.
+	Enabled   *bool   `json:"enabled,omitempty"`
+	Window    int64   `json:"window"`
+	Threshold float64 `json:"threshold"`
+}
+
+// CheckResult is the latest result of a check. An empty CheckedAt means the
+// check has not run yet.
+type CheckResult struct {
+	Status    string `json:"status"`
+	LatencyMS int64  `json:"latency_ms"`
+	Message   string `json:"message"`
+	CheckedAt string `json:"checked_at"`
+}
+
+// CheckKey identifies a check without its ID.
+type CheckKey struct {
+	HostAddress string `json:"host_address"`
+	Type        string `json:"type"`
+	Config      string `json:"config"`
+}
+
+// ListChecksOptions filters ListChecks. Empty fields do not filter.
+type ListChecksOptions struct {
+	HostAddress string
+	Topic       string
+	// Tags matches checks of hosts carrying all of these tags.
+	Tags map[string]string
+}
+
+// CheckBatchResult is the outcome of one check in UpsertChecks. Exactly one
+// of Check and Err is set.
+type CheckBatchResult struct {
+	Check *Check
+	Err   error
+}
+
+type hostChecksEnabledRequest struct {
+	HostAddress string `json:"host_address"`
+	Enabled     int    `json:"enabled"`
+}
+
+type hostChecksEnabledResponse struct {
+	Updated int64 `json:"updated"`
+}
+
+type checkBatchRequest struct {
+	Checks []CheckRequest `json:"checks"`
+}
+
+// checkBatchResponse holds one result per request item, in request order.
+// Failed items carry their status and a TinyMon error document.
+type checkBatchResponse struct {
+	Results []struct {
+		Status int             `json:"status"`
+		Check  Check           `json:"check"`
+		Error  json.RawMessage `json:"error"`
+	} `json:"results"`
+}
+
+// UpsertCheck creates the check identified by host address, type and config,
+// or updates it if it exists.
+func (c *Client) UpsertCheck(ctx context.Context, check CheckRequest) (*Check, error) {
+	var result Check
+	if err := c.DoJSON(ctx, "POST", "/api/push/checks", check, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpsertChecks creates or updates several checks in one request (TinyMon
+// 1.8+). It returns one result per check, in order. The error is set when
+// the request as a whole failed; servers without the batch endpoint answer
+// 404.
+func (c *Client) UpsertChecks(ctx context.Context, checks []CheckRequest) ([]CheckBatchResult, error) {
+	var result checkBatchResponse
+	if err := c.DoJSON(ctx, "POST", "/api/push/checks/batch", checkBatchRequest{Checks: checks}, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Results) != len(checks) {
+		return nil, &APIError{
+			Method:     "POST",
+			Path:       "/api/push/checks/batch",
+			StatusCode: http.StatusOK,
+			Message:    "batch response does not match the number of submitted checks",
+		}
+	}
+
+	results := make([]CheckBatchResult, len(checks))
+	for i, r := range result.Results {
+		if r.Status >= 300 {
+			results[i].Err = newAPIError("POST", "/api/push/checks/batch", r.Status, r.Error)
+			continue
+		}
+		check := r.Check
+		results[i].Check = &check
+	}
+	return results, nil
+}
+
+// GetCheck returns the check with id. It needs a server with ID-based
+// endpoints; use GetCheckByKey otherwise.
+func (c *Client) GetCheck(ctx context.Context, id int64) (*Check, error) {
+	var result Check
+	if err := c.DoJSON(ctx, "GET", fmt.Sprintf("/api/push/checks/%d", id), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCheckByKey returns the check identified by key.
+func (c *Client) GetCheckByKey(ctx context.Context, key CheckKey) (*Check, error) {
+	query := url.Values{}
+	query.Set("host_address", key.HostAddress)
+	query.Set("type", key.Type)
+	query.Set("config", key.Config)
+
+	var result Check
+	if err := c.DoJSON(ctx, "GET", "/api/push/checks?"+query.Encode(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListChecks returns all checks matching opts.
+func (c *Client) ListChecks(ctx context.Context, opts ListChecksOptions) ([]Check, error) {
+	query := url.Values{}
+	if opts.HostAddress != "" {
+		query.Set("host_address", opts.HostAddress)
+	}
+	if opts.Topic != "" {
+		query.Set("topic", opts.Topic)
+	}
+	addTagFilter(query, opts.Tags)
+
+	var checks []Check
+	if err := c.DoJSON(ctx, "GET", "/api/push/checks/list?"+query.Encode(), nil, &checks); err != nil {
+		return nil, err
+	}
+	return checks, nil
+}
+
+// DeleteCheck deletes the check with id. It needs a server with ID-based
+// endpoints; use DeleteCheckByKey otherwise.
+func (c *Client) DeleteCheck(ctx context.Context, id int64) error {
+	return c.DoJSON(ctx, "DELETE", fmt.Sprintf("/api/push/checks/%d", id), nil, nil)
+}
+
+// DeleteCheckByKey deletes the check identified by key.
+func (c *Client) DeleteCheckByKey(ctx context.Context, key CheckKey) error {
+	return c.DoJSON(ctx, "DELETE", "/api/push/checks", key, nil)
+}
+
+// GetCheckResult returns the latest result of the check with id. Servers
+// answer 404 while the check has no result.
+func (c *Client) GetCheckResult(ctx context.Context, id int64) (*CheckResult, error) {
+	var result CheckResult
+	if err := c.DoJSON(ctx, "GET", fmt.Sprintf("/api/push/checks/%d/result", id), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RunCheck schedules an immediate run of the check with id.
+func (c *Client) RunCheck(ctx context.Context, id int64) error {
+	return c.DoJSON(ctx, "POST", fmt.Sprintf("/api/push/checks/%d/run", id), nil, nil)
+}
+
+// SetHostChecksEnabled enables or disables every check of the host with
+// address and returns the number of checks changed.
+func (c *Client) SetHostChecksEnabled(ctx context.Context, address string, enabled bool) (int64, error) {
+	body := hostChecksEnabledRequest{HostAddress: address}
+	if enabled {
+		body.Enabled = 1
+	}
+
+	var result hostChecksEnabledResponse
+	if err := c.DoJSON(ctx, "POST", "/api/push/checks/enabled", body, &result); err != nil {
+		return 0, err
+	}
+	return result.Updated, nil
+}
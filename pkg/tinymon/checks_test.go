@@ -0,0 +1,117 @@
+package tinymon_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon/tinymontest"
+)
+
+func TestCheckUpsertGetDelete(t *testing.T) {
+	srv := tinymontest.NewServer()
+	defer srv.Close()
+	srv.AddHost(tinymon.HostRequest{Address: "web1"})
+	client := srv.Client()
+	ctx := context.Background()
+
+	key := tinymon.CheckKey{HostAddress: "web1", Type: "http", Config: `{"url":"https://web1/"}`}
+	created, err := client.UpsertCheck(ctx, tinymon.CheckRequest{
+		HostAddress:     key.HostAddress,
+		Type:            key.Type,
+		Config:          key.Config,
+		IntervalSeconds: 60,
+		Enabled:         1,
+	})
+	if err != nil {
+		t.Fatalf("UpsertCheck: %s", err)
+	}
+	if created.ID == 0 || created.HostAddress != "web1" || created.IntervalSeconds != 60 {
+		t.Fatalf("UpsertCheck returned %+v", created)
+	}
+
+	// Host address, type and config identify the check, so this updates it.
+	updated, err := client.UpsertCheck(ctx, tinymon.CheckRequest{
+		HostAddress:     key.HostAddress,
+		Type:            key.Type,
+		Config:          key.Config,
+		IntervalSeconds: 300,
+		Enabled:         1,
+	})
+	if err != nil {
+		t.Fatalf("UpsertCheck (update): %s", err)
+	}
+	if updated.ID != created.ID || updated.IntervalSeconds != 300 {
+		t.Fatalf("update: got ID %d interval %d, want ID %d interval 300", updated.ID, updated.IntervalSeconds, created.ID)
+	}
+
+	byID, err := client.GetCheck(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetCheck: %s", err)
+	}
+	byKey, err := client.GetCheckByKey(ctx, key)
+	if err != nil {
+		t.Fatalf("GetCheckByKey: %s", err)
+	}
+	if byID.IntervalSeconds != 300 || byKey.ID != created.ID {
+		t.Fatalf("GetCheck = %+v, GetCheckByKey = %+v", byID, byKey)
+	}
+
+	checks, err := client.ListChecks(ctx, tinymon.ListChecksOptions{HostAddress: "web1"})
+	if err != nil {
+		t.Fatalf("ListChecks: %s", err)
+	}
+	if len(checks) != 1 || checks[0].ID != created.ID {
+		t.Fatalf("ListChecks returned %+v", checks)
+	}
+
+	if err := client.DeleteCheckByKey(ctx, key); err != nil {
+		t.Fatalf("DeleteCheckByKey: %s", err)
+	}
+	if _, err := client.GetCheck(ctx, created.ID); !tinymon.IsNotFound(err) {
+		t.Fatalf("GetCheck after delete: got %v, want 404", err)
+	}
+	if err := client.DeleteCheck(ctx, created.ID); !tinymon.IsNotFound(err) {
+		t.Fatalf("DeleteCheck after delete: got %v, want 404", err)
+	}
+}
+
+func TestUpsertCheckUnknownHost(t *testing.T) {
+	srv := tinymontest.NewServer()
+	defer srv.Close()
+
+	_, err := srv.Client().UpsertCheck(context.Background(), tinymon.CheckRequest{HostAddress: "missing", Type: "ping"})
+	var apiErr *tinymon.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got %v, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusUnprocessableEntity || len(apiErr.FieldErrors) != 1 || apiErr.FieldErrors[0].Field != "host_address" {
+		t.Fatalf("got %+v, want a 422 with a host_address field error", apiErr)
+	}
+}
+
+func TestUpsertChecks(t *testing.T) {
+	srv := tinymontest.NewServer()
+	defer srv.Close()
+	srv.AddHost(tinymon.HostRequest{Address: "web1"})
+
+	results, err := srv.Client().UpsertChecks(context.Background(), []tinymon.CheckRequest{
+		{HostAddress: "web1", Type: "ping"},
+		{HostAddress: "missing", Type: "ping"},
+	})
+	if err != nil {
+		t.Fatalf("UpsertChecks: %s", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if results[0].Err != nil || results[0].Check == nil || results[0].Check.HostAddress != "web1" {
+		t.Errorf("result 0 = %+v, want the created check", results[0])
+	}
+	var apiErr *tinymon.APIError
+	if results[1].Check != nil || !errors.As(results[1].Err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("result 1 = %+v, want a 422 error", results[1])
+	}
+}
@@ -0,0 +1,303 @@
+// Package tinymon is a client for the TinyMon Push API. It is used by the
+// Terraform provider and can be imported by other Go tooling.
+//
+// Typed methods cover hosts and checks; DoJSON reaches every other endpoint.
+// Requests are logged at debug level through terraform-plugin-log, which is a
+// no-op unless the context carries a Terraform logger. Sensitive values are
+// redacted from logged bodies.
+package tinymon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// OrganizationHeader scopes a request to one organization. Without it the
+// server uses the API key's default organization.
+const OrganizationHeader = "X-TinyMon-Organization"
+
+// Config configures a Client.
+type Config struct {
+	// URL is the base URL of the TinyMon server, e.g. https://tinymon.example.com.
+	URL string
+
+	// APIKey authenticates requests as a Bearer token.
+	APIKey string
+	// APIKeySecondary is used once the server rejects APIKey, so a key can
+	// be rotated while a client is in use. Empty disables the fallback.
+	APIKeySecondary string
+
+	// Username and Password switch authentication to HTTP Basic auth. They
+	// are mutually exclusive with the API keys.
+	Username string
+	Password string
+
+	// Headers are added to every request.
+	Headers map[string]string
+
+	// HTTPClient sends the requests. Nil means http.DefaultClient.
+	HTTPClient *http.Client
+
+	// Organization scopes requests to one organization. Empty means the API
+	// key's default organization. WithOrganization overrides it per request.
+	Organization string
+
+	// RequestsPerSecond throttles outgoing requests. Zero means unlimited.
+	RequestsPerSecond float64
+
+	// ReadCache reuses successful GET responses for a few seconds. Any other
+	// request clears the cache.
+	ReadCache bool
+}
+
+// Client sends requests to the TinyMon Push API. It is safe for concurrent
+// use.
+type Client struct {
+	url             string
+	apiKey          string
+	apiKeySecondary string
+	useSecondaryKey atomic.Bool
+	username        string
+	password        string
+	headers         map[string]string
+	http            *http.Client
+	organization    string
+
+	// limiter throttles outgoing requests. Nil means unlimited.
+	limiter *rateLimiter
+	// cache reuses recent GET responses. Nil disables caching.
+	cache *readCache
+}
+
+// New returns a client for cfg.
+func New(cfg Config) *Client {
+	c := &Client{
+		url:             cfg.URL,
+		apiKey:          cfg.APIKey,
+		apiKeySecondary: cfg.APIKeySecondary,
+		username:        cfg.Username,
+		password:        cfg.Password,
+		headers:         cfg.Headers,
+		http:            cfg.HTTPClient,
+		organization:    cfg.Organization,
+	}
+	if c.http == nil {
+		c.http = http.DefaultClient
+	}
+	if cfg.RequestsPerSecond > 0 {
+		c.limiter = newRateLimiter(cfg.RequestsPerSecond)
+	}
+	if cfg.ReadCache {
+		c.cache = newReadCache()
+	}
+	return c
+}
+
+// DoJSON sends a JSON request to the API and decodes the JSON response into
+// result. The request is bound to ctx so cancellation aborts in-flight calls.
+// Non-2xx responses are returned as *APIError.
+func (c *Client) DoJSON(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	organization := c.OrganizationFor(ctx)
+
+	// Responses differ per organization, so they are cached separately.
+	cacheKey := path
+	if organization != "" {
+		cacheKey = organization + ":" + path
+	}
+
+	useCache := c.cache != nil && method == "GET" && !readCacheBypassed(ctx)
+	var cacheGeneration uint64
+	if useCache {
+		cached, generation, ok := c.cache.get(cacheKey)
+		if ok {
+			logPath, logQuery, _ := strings.Cut(path, "?")
+			tflog.Debug(ctx, "Using cached TinyMon API response", map[string]interface{}{
+				"method": method,
+				"path":   logPath,
+				"query":  redactQuery(logQuery),
+			})
+			if result != nil && len(cached) > 0 {
+				if err := json.Unmarshal(cached, result); err != nil {
+					return fmt.Errorf("unmarshalling response: %w", err)
+				}
+			}
+			return nil
+		}
+		cacheGeneration = generation
+	} else if c.cache != nil && method != "GET" {
+		c.cache.clear()
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return fmt.Errorf("waiting for rate limiter: %w", err)
+		}
+	}
+
+	var reqData []byte
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshalling request body: %w", err)
+		}
+		reqData = data
+	}
+
+	apiKey := c.activeAPIKey()
+	status, respBody, err := c.send(ctx, method, path, reqData, organization, apiKey)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusUnauthorized && c.apiKeySecondary != "" && apiKey != c.apiKeySecondary {
+		// The primary key was revoked mid-rotation. Switch to the secondary
+		// for this and all later requests.
+		tflog.Warn(ctx, "TinyMon rejected the primary API key, switching to the secondary key")
+		c.useSecondaryKey.Store(true)
+		status, respBody, err = c.send(ctx, method, path, reqData, organization, c.apiKeySecondary)
+		if err != nil {
+			return err
+		}
+	}
+
+	if status < 200 || status >= 300 {
+		return newAPIError(method, path, status, respBody)
+	}
+	if useCache {
+		c.cache.put(cacheKey, respBody, cacheGeneration)
+	}
+
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("unmarshalling response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Ping verifies that the server is reachable and accepts the credentials.
+// Servers without the ping endpoint answer 404, which still proves
+// connectivity.
+func (c *Client) Ping(ctx context.Context) error {
+	err := c.DoJSON(ctx, "GET", "/api/push/ping", nil, nil)
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// send performs a single request with apiKey and returns the status code and
+// response body. A nil reqData sends no body.
+func (c *Client) send(ctx context.Context, method, path string, reqData []byte, organization, apiKey string) (int, []byte, error) {
+	url := strings.TrimRight(c.url, "/") + path
+
+	var reqBody io.Reader
+	if reqData != nil {
+		reqBody = bytes.NewReader(reqData)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return 0, nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+	if organization != "" {
+		req.Header.Set(OrganizationHeader, organization)
+	}
+	if reqData != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	for _, secret := range []string{c.apiKey, c.apiKeySecondary, c.password} {
+		if secret != "" {
+			ctx = tflog.MaskAllFieldValuesStrings(ctx, secret)
+		}
+	}
+	logPath, logQuery, _ := strings.Cut(path, "?")
+	tflog.Debug(ctx, "Sending TinyMon API request", map[string]interface{}{
+		"method": method,
+		"path":   logPath,
+		"query":  redactQuery(logQuery),
+		"body":   redactBody(reqData),
+	})
+
+	start := time.Now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		tflog.Debug(ctx, "TinyMon API request failed", map[string]interface{}{
+			"method":     method,
+			"path":       logPath,
+			"latency_ms": time.Since(start).Milliseconds(),
+			"error":      err.Error(),
+		})
+		return 0, nil, fmt.Errorf("executing request %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	tflog.Debug(ctx, "Received TinyMon API response", map[string]interface{}{
+		"method":     method,
+		"path":       logPath,
+		"status":     resp.StatusCode,
+		"latency_ms": time.Since(start).Milliseconds(),
+		"body":       redactBody(respBody),
+	})
+	return resp.StatusCode, respBody, nil
+}
+
+// activeAPIKey returns the key requests are sent with: the primary until the
+// server rejected it, then the secondary.
+func (c *Client) activeAPIKey() string {
+	if c.useSecondaryKey.Load() {
+		return c.apiKeySecondary
+	}
+	return c.apiKey
+}
+
+type organizationKey struct{}
+
+// WithOrganization scopes all requests made with ctx to organization instead
+// of the client's. An empty organization keeps the client's.
+func WithOrganization(ctx context.Context, organization string) context.Context {
+	if organization == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, organizationKey{}, organization)
+}
+
+// OrganizationOverridden reports whether ctx carries an organization set with
+// WithOrganization.
+func OrganizationOverridden(ctx context.Context) bool {
+	_, ok := ctx.Value(organizationKey{}).(string)
+	return ok
+}
+
+// OrganizationFor returns the organization requests made with ctx are scoped
+// to, or "" for the API key's default.
+func (c *Client) OrganizationFor(ctx context.Context) string {
+	if org, ok := ctx.Value(organizationKey{}).(string); ok {
+		return org
+	}
+	return c.organization
+}
@@ -0,0 +1,88 @@
+package tinymon_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon/tinymontest"
+)
+
+func TestSecondaryAPIKeyFallback(t *testing.T) {
+	srv := tinymontest.NewServer()
+	defer srv.Close()
+	// The primary key has been revoked; only the secondary is accepted.
+	srv.APIKey = "new-key"
+	srv.AddHost(tinymon.HostRequest{Address: "web1"})
+	ctx := context.Background()
+
+	client := tinymon.New(tinymon.Config{
+		URL:             srv.URL,
+		APIKey:          "old-key",
+		APIKeySecondary: "new-key",
+		HTTPClient:      srv.Server.Client(),
+	})
+	if _, err := client.GetHostByAddress(ctx, "web1"); err != nil {
+		t.Fatalf("GetHostByAddress with revoked primary key: %s", err)
+	}
+	// Later requests go straight to the secondary key.
+	if _, err := client.ListHosts(ctx, tinymon.ListHostsOptions{}); err != nil {
+		t.Fatalf("ListHosts after switching keys: %s", err)
+	}
+
+	withoutFallback := tinymon.New(tinymon.Config{
+		URL:        srv.URL,
+		APIKey:     "old-key",
+		HTTPClient: srv.Server.Client(),
+	})
+	_, err := withoutFallback.GetHostByAddress(ctx, "web1")
+	var apiErr *tinymon.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("without secondary key: got %v, want 401", err)
+	}
+}
+
+func TestReadCacheClearedByWrites(t *testing.T) {
+	srv := tinymontest.NewServer()
+	defer srv.Close()
+	srv.AddHost(tinymon.HostRequest{Address: "web1", Name: "before"})
+	ctx := context.Background()
+
+	client := tinymon.New(tinymon.Config{
+		URL:        srv.URL,
+		HTTPClient: srv.Server.Client(),
+		ReadCache:  true,
+	})
+	hostName := func(ctx context.Context) string {
+		t.Helper()
+		host, err := client.GetHostByAddress(ctx, "web1")
+		if err != nil {
+			t.Fatalf("GetHostByAddress: %s", err)
+		}
+		return host.Name
+	}
+
+	if got := hostName(ctx); got != "before" {
+		t.Fatalf("first read: got %q, want %q", got, "before")
+	}
+
+	// Changed behind the client's back: the cached response is reused.
+	srv.AddHost(tinymon.HostRequest{Address: "web1", Name: "after"})
+	if got := hostName(ctx); got != "before" {
+		t.Fatalf("cached read: got %q, want %q", got, "before")
+	}
+	if got := hostName(tinymon.WithoutReadCache(ctx)); got != "after" {
+		t.Fatalf("read bypassing the cache: got %q, want %q", got, "after")
+	}
+
+	// Any write through the client clears the cache.
+	if _, err := client.UpsertHost(ctx, tinymon.HostRequest{Address: "web2"}); err != nil {
+		t.Fatalf("UpsertHost: %s", err)
+	}
+	srv.AddHost(tinymon.HostRequest{Address: "web1", Name: "latest"})
+	if got := hostName(ctx); got != "latest" {
+		t.Fatalf("read after write: got %q, want %q", got, "latest")
+	}
+}
@@ -0,0 +1,71 @@
+package tinymon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APIError is returned when the API responds with a non-2xx status. Code,
+// Message and FieldErrors are filled when the body is a TinyMon error
+// document:
+//
+//	{"code": "validation_failed", "message": "...", "errors": [{"field": "config", "message": "..."}]}
+type APIError struct {
+	Method      string
+	Path        string
+	StatusCode  int
+	Body        string
+	Code        string
+	Message     string
+	FieldErrors []APIFieldError
+}
+
+// APIFieldError is a validation error for a single request field.
+type APIFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type apiErrorBody struct {
+	Code    string          `json:"code"`
+	Message string          `json:"message"`
+	Errors  []APIFieldError `json:"errors"`
+}
+
+func newAPIError(method, path string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		Method:     method,
+		Path:       path,
+		StatusCode: statusCode,
+		Body:       string(body),
+	}
+
+	var parsed apiErrorBody
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		apiErr.Code = parsed.Code
+		apiErr.Message = parsed.Message
+		apiErr.FieldErrors = parsed.Errors
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("API %s %s returned status %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+	}
+
+	msg := fmt.Sprintf("API %s %s returned status %d: %s", e.Method, e.Path, e.StatusCode, e.Message)
+	if e.Code != "" {
+		msg += " (" + e.Code + ")"
+	}
+	return msg
+}
+
+// IsNotFound reports whether err is an API 404 response.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
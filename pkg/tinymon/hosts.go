@@ -0,0 +1,116 @@
+package tinymon
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// HostRequest creates or updates a host. Hosts are keyed by Address.
+type HostRequest struct {
+	Address       string            `json:"address"`
+	Name          string            `json:"name,omitempty"`
+	Description   string            `json:"description"`
+	Topic         string            `json:"topic"`
+	ParentAddress string            `json:"parent_address"`
+	Enabled       int               `json:"enabled"`
+	Tags          map[string]string `json:"tags"`
+	TagIDs        []int64           `json:"tag_ids"`
+}
+
+// Host is a host as returned by the API.
+type Host struct {
+	ID            int64             `json:"id"`
+	Name          string            `json:"name"`
+	Address       string            `json:"address"`
+	Description   string            `json:"description"`
+	Topic         string            `json:"topic"`
+	ParentAddress string            `json:"parent_address"`
+	Enabled       int               `json:"enabled"`
+	Tags          map[string]string `json:"tags"`
+	TagIDs        []int64           `json:"tag_ids"`
+}
+
+// ListHostsOptions filters ListHosts. Empty fields do not filter.
+type ListHostsOptions struct {
+	Topic string
+	// Tags matches hosts carrying all of these tags.
+	Tags map[string]string
+}
+
+type hostDeleteRequest struct {
+	Address string `json:"address"`
+}
+
+// UpsertHost creates the host with host.Address, or updates it if it exists.
+func (c *Client) UpsertHost(ctx context.Context, host HostRequest) (*Host, error) {
+	var result Host
+	if err := c.DoJSON(ctx, "POST", "/api/push/hosts", host, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetHost returns the host with id. It needs a server with ID-based
+// endpoints; use GetHostByAddress otherwise.
+func (c *Client) GetHost(ctx context.Context, id int64) (*Host, error) {
+	var result Host
+	if err := c.DoJSON(ctx, "GET", fmt.Sprintf("/api/push/hosts/%d", id), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetHostByAddress returns the host with address.
+func (c *Client) GetHostByAddress(ctx context.Context, address string) (*Host, error) {
+	var result Host
+	if err := c.DoJSON(ctx, "GET", "/api/push/hosts?address="+url.QueryEscape(address), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListHosts returns all hosts matching opts.
+func (c *Client) ListHosts(ctx context.Context, opts ListHostsOptions) ([]Host, error) {
+	query := url.Values{}
+	if opts.Topic != "" {
+		query.Set("topic", opts.Topic)
+	}
+	addTagFilter(query, opts.Tags)
+
+	apiPath := "/api/push/hosts/list"
+	if len(query) > 0 {
+		apiPath += "?" + query.Encode()
+	}
+
+	var hosts []Host
+	if err := c.DoJSON(ctx, "GET", apiPath, nil, &hosts); err != nil {
+		return nil, err
+	}
+	return hosts, nil
+}
+
+// DeleteHost deletes the host with id and its checks. It needs a server with
+// ID-based endpoints; use DeleteHostByAddress otherwise.
+func (c *Client) DeleteHost(ctx context.Context, id int64) error {
+	return c.DoJSON(ctx, "DELETE", fmt.Sprintf("/api/push/hosts/%d", id), nil, nil)
+}
+
+// DeleteHostByAddress deletes the host with address and its checks.
+func (c *Client) DeleteHostByAddress(ctx context.Context, address string) error {
+	return c.DoJSON(ctx, "DELETE", "/api/push/hosts", hostDeleteRequest{Address: address}, nil)
+}
+
+// addTagFilter adds one tag=key=value parameter per tag. Tags are added in key
+// order so equal filters produce equal paths for the read cache.
+func addTagFilter(query url.Values, tags map[string]string) {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		query.Add("tag", key+"="+tags[key])
+	}
+}
@@ -0,0 +1,97 @@
+package tinymon_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon/tinymontest"
+)
+
+func TestHostUpsertGetDelete(t *testing.T) {
+	srv := tinymontest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	created, err := client.UpsertHost(ctx, tinymon.HostRequest{
+		Address: "tf-test-web1",
+		Name:    "Web 1",
+		Topic:   "production",
+		Enabled: 1,
+		Tags:    map[string]string{"env": "prod"},
+	})
+	if err != nil {
+		t.Fatalf("UpsertHost: %s", err)
+	}
+	if created.ID == 0 || created.Name != "Web 1" || created.Topic != "production" {
+		t.Fatalf("UpsertHost returned %+v", created)
+	}
+
+	// Upserting the same address updates the host in place.
+	updated, err := client.UpsertHost(ctx, tinymon.HostRequest{Address: "tf-test-web1", Name: "Web One", Enabled: 1})
+	if err != nil {
+		t.Fatalf("UpsertHost (update): %s", err)
+	}
+	if updated.ID != created.ID || updated.Name != "Web One" {
+		t.Fatalf("update: got ID %d name %q, want ID %d name %q", updated.ID, updated.Name, created.ID, "Web One")
+	}
+
+	byID, err := client.GetHost(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetHost: %s", err)
+	}
+	byAddress, err := client.GetHostByAddress(ctx, "tf-test-web1")
+	if err != nil {
+		t.Fatalf("GetHostByAddress: %s", err)
+	}
+	if byID.Name != "Web One" || byAddress.ID != created.ID {
+		t.Fatalf("GetHost = %+v, GetHostByAddress = %+v", byID, byAddress)
+	}
+
+	if err := client.DeleteHost(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteHost: %s", err)
+	}
+	if _, err := client.GetHost(ctx, created.ID); !tinymon.IsNotFound(err) {
+		t.Fatalf("GetHost after delete: got %v, want 404", err)
+	}
+	if err := client.DeleteHostByAddress(ctx, "tf-test-web1"); !tinymon.IsNotFound(err) {
+		t.Fatalf("DeleteHostByAddress after delete: got %v, want 404", err)
+	}
+}
+
+func TestListHosts(t *testing.T) {
+	srv := tinymontest.NewServer()
+	defer srv.Close()
+	srv.AddHost(tinymon.HostRequest{Address: "web1", Topic: "production", Tags: map[string]string{"env": "prod", "team": "shop"}})
+	srv.AddHost(tinymon.HostRequest{Address: "web2", Topic: "production", Tags: map[string]string{"env": "prod"}})
+	srv.AddHost(tinymon.HostRequest{Address: "dev1", Topic: "development", Tags: map[string]string{"env": "dev"}})
+
+	tests := []struct {
+		name string
+		opts tinymon.ListHostsOptions
+		want []string
+	}{
+		{"all", tinymon.ListHostsOptions{}, []string{"web1", "web2", "dev1"}},
+		{"topic", tinymon.ListHostsOptions{Topic: "production"}, []string{"web1", "web2"}},
+		{"tag", tinymon.ListHostsOptions{Tags: map[string]string{"env": "prod"}}, []string{"web1", "web2"}},
+		{"all tags", tinymon.ListHostsOptions{Tags: map[string]string{"env": "prod", "team": "shop"}}, []string{"web1"}},
+		{"no match", tinymon.ListHostsOptions{Topic: "development", Tags: map[string]string{"env": "prod"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hosts, err := srv.Client().ListHosts(context.Background(), tt.opts)
+			if err != nil {
+				t.Fatalf("ListHosts: %s", err)
+			}
+			var got []string
+			for _, host := range hosts {
+				got = append(got, host.Address)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package tinymon
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// maxLoggedBodyBytes caps request/response bodies written to the debug log.
+const maxLoggedBodyBytes = 2048
+
+// sensitiveKeys are JSON keys whose values never appear in logs. Matching is
+// case-insensitive and by substring, so "smtp_password" is covered too.
+var sensitiveKeys = []string{
+	"password",
+	"secret",
+	"token",
+	"api_key",
+	"apikey",
+	"private_key",
+	"routing_key",
+	"community",
+	"authorization",
+	"webhook_url",
+	"smtp_username",
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactBody returns body with sensitive values replaced, truncated for
+// logging. Check configs are JSON documents embedded as strings, so string
+// values that parse as JSON objects are redacted recursively.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return truncateForLog("<non-JSON body redacted>")
+	}
+
+	redacted, err := json.Marshal(redactValue(parsed))
+	if err != nil {
+		return truncateForLog("<unserializable body redacted>")
+	}
+	return truncateForLog(string(redacted))
+}
+
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, inner := range value {
+			if isSensitiveKey(key) {
+				value[key] = "***"
+				continue
+			}
+			value[key] = redactValue(inner)
+		}
+		return value
+	case []interface{}:
+		for i, inner := range value {
+			value[i] = redactValue(inner)
+		}
+		return value
+	case string:
+		if !strings.HasPrefix(strings.TrimSpace(value), "{") {
+			return value
+		}
+		var embedded map[string]interface{}
+		if err := json.Unmarshal([]byte(value), &embedded); err != nil {
+			return value
+		}
+		data, err := json.Marshal(redactValue(embedded))
+		if err != nil {
+			return value
+		}
+		return string(data)
+	default:
+		return value
+	}
+}
+
+// redactQuery redacts sensitive parameters and embedded JSON configs in a raw
+// query string.
+func redactQuery(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "<unparseable query redacted>"
+	}
+	for key, list := range values {
+		for i, value := range list {
+			if isSensitiveKey(key) {
+				list[i] = "***"
+				continue
+			}
+			list[i] = redactValue(value).(string)
+		}
+	}
+	return truncateForLog(values.Encode())
+}
+
+func truncateForLog(s string) string {
+	if len(s) <= maxLoggedBodyBytes {
+		return s
+	}
+	return s[:maxLoggedBodyBytes] + "...(truncated)"
+}
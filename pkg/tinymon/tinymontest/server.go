@@ -0,0 +1,528 @@
+// Package tinymontest provides an in-memory fake of the TinyMon Push API for
+// tests. It serves the host and check endpoints used by the tinymon client
+// and the Terraform provider, so both can be exercised without a live server.
+//
+//	srv := tinymontest.NewServer()
+//	defer srv.Close()
+//	client := srv.Client()
+//
+// For provider acceptance tests, point the provider's url at srv.URL. The
+// fake accepts any credentials unless APIKey is set, answers 404 for
+// endpoints it does not implement, and ignores the organization header.
+package tinymontest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/unclesamwk/terraform-provider-tinymon/pkg/tinymon"
+)
+
+// DefaultVersion is the server version reported by a new Server. It enables
+// every feature the provider gates on the version.
+const DefaultVersion = "1.10.0"
+
+// Server is a fake TinyMon server. Its fields may be changed before the
+// first request.
+type Server struct {
+	*httptest.Server
+
+	// Version is reported by /api/push/version. Empty answers 404, like
+	// servers older than 1.4.
+	Version string
+	// CheckTypes is reported by /api/push/check_types. Nil answers 404, so
+	// the provider falls back to its static list.
+	CheckTypes []string
+	// APIKey, if set, is the only Bearer token accepted. Other requests are
+	// answered with 401.
+	APIKey string
+
+	mu        sync.Mutex
+	nextID    int64
+	hosts     map[int64]*tinymon.Host
+	checks    map[int64]*tinymon.Check
+	results   map[int64]*tinymon.CheckResult
+	templates map[int64]*CheckTemplate
+}
+
+// CheckTemplate is a check template stored with AddCheckTemplate. A check
+// with its ID as template_id gets the template's config and thresholds.
+type CheckTemplate struct {
+	ID                int64
+	Type              string
+	Config            string
+	WarningThreshold  *tinymon.CheckThreshold
+	CriticalThreshold *tinymon.CheckThreshold
+}
+
+// NewServer starts a fake server with no hosts or checks. The caller must
+// call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		Version:   DefaultVersion,
+		hosts:     map[int64]*tinymon.Host{},
+		checks:    map[int64]*tinymon.Check{},
+		results:   map[int64]*tinymon.CheckResult{},
+		templates: map[int64]*CheckTemplate{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a tinymon client for the server, authenticated with APIKey.
+func (s *Server) Client() *tinymon.Client {
+	return tinymon.New(tinymon.Config{
+		URL:        s.URL,
+		APIKey:     s.APIKey,
+		HTTPClient: s.Server.Client(),
+	})
+}
+
+// AddHost stores host as if it had been created through the API and returns
+// it with its assigned ID.
+func (s *Server) AddHost(host tinymon.HostRequest) tinymon.Host {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.upsertHost(host)
+}
+
+// AddCheck stores check as if it had been created through the API and
+// returns it with its assigned ID. The check's host must exist.
+func (s *Server) AddCheck(check tinymon.CheckRequest) (tinymon.Check, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, apiErr := s.upsertCheck(check)
+	if apiErr != nil {
+		return tinymon.Check{}, errors.New(apiErr.Message)
+	}
+	return *stored, nil
+}
+
+// AddCheckTemplate stores template and returns it with its assigned ID. The
+// check template endpoints are not served.
+func (s *Server) AddCheckTemplate(template CheckTemplate) CheckTemplate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	template.ID = s.nextID
+	s.templates[template.ID] = &template
+	return template
+}
+
+// Hosts returns all stored hosts ordered by ID.
+func (s *Server) Hosts() []tinymon.Host {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.listHosts("", nil)
+}
+
+// Checks returns all stored checks ordered by ID.
+func (s *Server) Checks() []tinymon.Check {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.listChecks("", "", nil)
+}
+
+// SetCheckResult sets the latest result of the check with id, as if the
+// check had run.
+func (s *Server) SetCheckResult(id int64, result tinymon.CheckResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results[id] = &result
+}
+
+// apiError is the TinyMon error document.
+type apiError struct {
+	status  int
+	Code    string                  `json:"code"`
+	Message string                  `json:"message"`
+	Errors  []tinymon.APIFieldError `json:"errors,omitempty"`
+}
+
+func notFound(format string, args ...interface{}) *apiError {
+	return &apiError{status: http.StatusNotFound, Code: "not_found", Message: fmt.Sprintf(format, args...)}
+}
+
+func invalid(field, message string) *apiError {
+	return &apiError{
+		status:  http.StatusUnprocessableEntity,
+		Code:    "validation_failed",
+		Message: message,
+		Errors:  []tinymon.APIFieldError{{Field: field, Message: message}},
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.APIKey != "" && r.Header.Get("Authorization") != "Bearer "+s.APIKey {
+		writeJSON(w, http.StatusUnauthorized, &apiError{Code: "unauthorized", Message: "invalid API key"})
+		return
+	}
+
+	// Results point into the store, so they are encoded under the lock.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, apiErr := s.route(r)
+	if apiErr != nil {
+		writeJSON(w, apiErr.status, apiErr)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// route dispatches a request. The caller must hold s.mu.
+func (s *Server) route(r *http.Request) (interface{}, *apiError) {
+	const prefix = "/api/push/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		return nil, notFound("no route for %s %s", r.Method, r.URL.Path)
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	query := r.URL.Query()
+	route := r.Method + " " + parts[0]
+
+	switch {
+	case route == "GET ping" && len(parts) == 1:
+		return map[string]string{"status": "ok"}, nil
+	case route == "GET version" && len(parts) == 1 && s.Version != "":
+		return map[string]interface{}{"version": s.Version, "edition": "community", "features": []string{}}, nil
+	case route == "GET check_types" && len(parts) == 1 && s.CheckTypes != nil:
+		return s.CheckTypes, nil
+
+	case parts[0] == "hosts":
+		return s.routeHosts(r, parts[1:], query.Get)
+	case parts[0] == "checks":
+		return s.routeChecks(r, parts[1:], query.Get)
+	}
+	return nil, notFound("no route for %s %s", r.Method, r.URL.Path)
+}
+
+func (s *Server) routeHosts(r *http.Request, parts []string, query func(string) string) (interface{}, *apiError) {
+	switch {
+	case len(parts) == 0 && r.Method == "POST":
+		var req tinymon.HostRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, invalid("", "invalid JSON body: "+err.Error())
+		}
+		if req.Address == "" {
+			return nil, invalid("address", "address is required")
+		}
+		return s.upsertHost(req), nil
+
+	case len(parts) == 0 && r.Method == "GET":
+		host := s.hostByAddress(query("address"))
+		if host == nil {
+			return nil, notFound("host %q not found", query("address"))
+		}
+		return host, nil
+
+	case len(parts) == 0 && r.Method == "DELETE":
+		var req struct {
+			Address string `json:"address"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, invalid("", "invalid JSON body: "+err.Error())
+		}
+		host := s.hostByAddress(req.Address)
+		if host == nil {
+			return nil, notFound("host %q not found", req.Address)
+		}
+		s.deleteHost(host.ID)
+		return nil, nil
+
+	case len(parts) == 1 && parts[0] == "list" && r.Method == "GET":
+		return s.listHosts(query("topic"), r.URL.Query()["tag"]), nil
+
+	case len(parts) == 1 && (r.Method == "GET" || r.Method == "DELETE"):
+		id, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil || s.hosts[id] == nil {
+			return nil, notFound("host %s not found", parts[0])
+		}
+		if r.Method == "DELETE" {
+			s.deleteHost(id)
+			return nil, nil
+		}
+		return s.hosts[id], nil
+	}
+	return nil, notFound("no route for %s %s", r.Method, r.URL.Path)
+}
+
+func (s *Server) routeChecks(r *http.Request, parts []string, query func(string) string) (interface{}, *apiError) {
+	switch {
+	case len(parts) == 0 && r.Method == "POST":
+		var req tinymon.CheckRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, invalid("", "invalid JSON body: "+err.Error())
+		}
+		return s.upsertCheck(req)
+
+	case len(parts) == 0 && r.Method == "GET":
+		check := s.checkByKey(tinymon.CheckKey{HostAddress: query("host_address"), Type: query("type"), Config: query("config")})
+		if check == nil {
+			return nil, notFound("check not found")
+		}
+		return check, nil
+
+	case len(parts) == 0 && r.Method == "DELETE":
+		var key tinymon.CheckKey
+		if err := json.NewDecoder(r.Body).Decode(&key); err != nil {
+			return nil, invalid("", "invalid JSON body: "+err.Error())
+		}
+		check := s.checkByKey(key)
+		if check == nil {
+			return nil, notFound("check not found")
+		}
+		s.deleteCheck(check.ID)
+		return nil, nil
+
+	case len(parts) == 1 && parts[0] == "list" && r.Method == "GET":
+		return s.listChecks(query("host_address"), query("topic"), r.URL.Query()["tag"]), nil
+
+	case len(parts) == 1 && parts[0] == "batch" && r.Method == "POST":
+		var req struct {
+			Checks []tinymon.CheckRequest `json:"checks"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, invalid("", "invalid JSON body: "+err.Error())
+		}
+		type batchResult struct {
+			Status int            `json:"status"`
+			Check  *tinymon.Check `json:"check,omitempty"`
+			Error  *apiError      `json:"error,omitempty"`
+		}
+		results := make([]batchResult, len(req.Checks))
+		for i, check := range req.Checks {
+			stored, apiErr := s.upsertCheck(check)
+			if apiErr != nil {
+				results[i] = batchResult{Status: apiErr.status, Error: apiErr}
+				continue
+			}
+			results[i] = batchResult{Status: http.StatusOK, Check: stored}
+		}
+		return map[string]interface{}{"results": results}, nil
+
+	case len(parts) == 1 && parts[0] == "enabled" && r.Method == "POST":
+		var req struct {
+			HostAddress string `json:"host_address"`
+			Enabled     int    `json:"enabled"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, invalid("", "invalid JSON body: "+err.Error())
+		}
+		if s.hostByAddress(req.HostAddress) == nil {
+			return nil, notFound("host %q not found", req.HostAddress)
+		}
+		var updated int64
+		for _, check := range s.checks {
+			if check.HostAddress == req.HostAddress && check.Enabled != req.Enabled {
+				check.Enabled = req.Enabled
+				updated++
+			}
+		}
+		return map[string]int64{"updated": updated}, nil
+	}
+
+	if len(parts) == 0 || len(parts) > 2 {
+		return nil, notFound("no route for %s %s", r.Method, r.URL.Path)
+	}
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || s.checks[id] == nil {
+		return nil, notFound("check %s not found", parts[0])
+	}
+
+	switch {
+	case len(parts) == 1 && r.Method == "GET":
+		return s.checks[id], nil
+	case len(parts) == 1 && r.Method == "DELETE":
+		s.deleteCheck(id)
+		return nil, nil
+	case parts[1] == "result" && r.Method == "GET":
+		result := s.results[id]
+		if result == nil {
+			return nil, notFound("check %d has no result yet", id)
+		}
+		return result, nil
+	case parts[1] == "run" && r.Method == "POST":
+		// Checks run instantly and always succeed.
+		s.results[id] = &tinymon.CheckResult{
+			Status:    "ok",
+			LatencyMS: 1,
+			Message:   "run by tinymontest",
+			CheckedAt: time.Now().UTC().Format(time.RFC3339Nano),
+		}
+		return nil, nil
+	}
+	return nil, notFound("no route for %s %s", r.Method, r.URL.Path)
+}
+
+func (s *Server) upsertHost(req tinymon.HostRequest) *tinymon.Host {
+	host := s.hostByAddress(req.Address)
+	if host == nil {
+		s.nextID++
+		host = &tinymon.Host{ID: s.nextID}
+		s.hosts[host.ID] = host
+	}
+
+	host.Address = req.Address
+	host.Name = req.Name
+	if host.Name == "" {
+		host.Name = req.Address
+	}
+	host.Description = req.Description
+	host.Topic = req.Topic
+	host.ParentAddress = req.ParentAddress
+	host.Enabled = req.Enabled
+	host.Tags = req.Tags
+	if host.Tags == nil {
+		host.Tags = map[string]string{}
+	}
+	host.TagIDs = req.TagIDs
+	if host.TagIDs == nil {
+		host.TagIDs = []int64{}
+	}
+	return host
+}
+
+func (s *Server) upsertCheck(req tinymon.CheckRequest) (*tinymon.Check, *apiError) {
+	host := s.hostByAddress(req.HostAddress)
+	if host == nil {
+		return nil, invalid("host_address", fmt.Sprintf("host %q does not exist", req.HostAddress))
+	}
+	if req.Type == "" {
+		return nil, invalid("type", "type is required")
+	}
+	// Like the real server, the template's settings replace the request's.
+	if req.TemplateID != 0 {
+		template := s.templates[req.TemplateID]
+		if template == nil {
+			return nil, invalid("template_id", fmt.Sprintf("check template %d does not exist", req.TemplateID))
+		}
+		if template.Type != req.Type {
+			return nil, invalid("template_id", fmt.Sprintf("check template %d is for %s checks", req.TemplateID, template.Type))
+		}
+		req.Config = template.Config
+		req.WarningThreshold = template.WarningThreshold
+		req.CriticalThreshold = template.CriticalThreshold
+	}
+
+	check := s.checkByKey(tinymon.CheckKey{HostAddress: req.HostAddress, Type: req.Type, Config: req.Config})
+	if check == nil {
+		s.nextID++
+		check = &tinymon.Check{ID: s.nextID}
+		s.checks[check.ID] = check
+	}
+
+	check.HostID = host.ID
+	check.HostAddress = req.HostAddress
+	check.Type = req.Type
+	check.Name = req.Name
+	check.Config = req.Config
+	check.IntervalSeconds = req.IntervalSeconds
+	check.Enabled = req.Enabled
+	check.DependsOnCheckID = req.DependsOnCheckID
+	check.TemplateID = req.TemplateID
+	check.Locations = req.Locations
+	if check.Locations == nil {
+		check.Locations = []string{}
+	}
+	check.TagIDs = req.TagIDs
+	if check.TagIDs == nil {
+		check.TagIDs = []int64{}
+	}
+	check.FailuresBeforeAlert = req.FailuresBeforeAlert
+	check.RetryIntervalSeconds = req.RetryIntervalSeconds
+	check.WarningThreshold = req.WarningThreshold
+	check.CriticalThreshold = req.CriticalThreshold
+	check.FlapDetection = req.FlapDetection
+	// Secrets are write-only and never returned.
+	return check, nil
+}
+
+func (s *Server) hostByAddress(address string) *tinymon.Host {
+	for _, host := range s.hosts {
+		if host.Address == address {
+			return host
+		}
+	}
+	return nil
+}
+
+func (s *Server) checkByKey(key tinymon.CheckKey) *tinymon.Check {
+	for _, check := range s.checks {
+		if check.HostAddress == key.HostAddress && check.Type == key.Type && check.Config == key.Config {
+			return check
+		}
+	}
+	return nil
+}
+
+// deleteHost deletes a host and, like the real server, its checks.
+func (s *Server) deleteHost(id int64) {
+	address := s.hosts[id].Address
+	delete(s.hosts, id)
+	for checkID, check := range s.checks {
+		if check.HostAddress == address {
+			s.deleteCheck(checkID)
+		}
+	}
+}
+
+func (s *Server) deleteCheck(id int64) {
+	delete(s.checks, id)
+	delete(s.results, id)
+}
+
+func (s *Server) listHosts(topic string, tags []string) []tinymon.Host {
+	hosts := []tinymon.Host{}
+	for _, host := range s.hosts {
+		if (topic == "" || host.Topic == topic) && hasTags(host, tags) {
+			hosts = append(hosts, *host)
+		}
+	}
+	sort.Slice(hosts, func(i, j int) bool { return hosts[i].ID < hosts[j].ID })
+	return hosts
+}
+
+func (s *Server) listChecks(hostAddress, topic string, tags []string) []tinymon.Check {
+	checks := []tinymon.Check{}
+	for _, check := range s.checks {
+		if hostAddress != "" && check.HostAddress != hostAddress {
+			continue
+		}
+		if topic != "" && s.hosts[check.HostID].Topic != topic {
+			continue
+		}
+		if !hasTags(s.hosts[check.HostID], tags) {
+			continue
+		}
+		checks = append(checks, *check)
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].ID < checks[j].ID })
+	return checks
+}
+
+// hasTags reports whether host carries all tags, given as key=value.
+func hasTags(host *tinymon.Host, tags []string) bool {
+	for _, tag := range tags {
+		key, value, _ := strings.Cut(tag, "=")
+		if v, ok := host.Tags[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}